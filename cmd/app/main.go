@@ -12,10 +12,12 @@ import (
 
 	"github.com/Ari-Pari/backend/internal/api"
 	generated "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/auth"
 	"github.com/Ari-Pari/backend/internal/clients/dbstorage"
 	"github.com/Ari-Pari/backend/internal/clients/filestorage"
 	"github.com/Ari-Pari/backend/internal/config"
 	"github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -40,7 +42,7 @@ func main() {
 
 	queries := db.New(dbPool.Pool)
 
-	fileStore, err := setupMinioStorage(ctx, cfg)
+	fileStore, err := setupFileStorage(ctx, cfg)
 
 	if err != nil {
 		log.Fatalf("Failed to setup storage: %v", err)
@@ -48,14 +50,19 @@ func main() {
 
 	logger := log.New(os.Stdout, "API: ", log.LstdFlags|log.Lshortfile)
 
-	server := api.NewServer(logger, queries, fileStore)
+	server := api.NewServer(logger, queries, fileStore,
+		api.HealthCheck{Name: "postgres", Check: dbPool.Ping},
+		api.HealthCheck{Name: cfg.Storage.Driver, Check: fileStore.Ping},
+	).WithAdmin(adminService.NewAdminService(dbPool.Pool, queries, fileStore))
 
-	router := setupRouter(server, logger)
+	authenticator := setupAuth(cfg, logger)
+
+	router := setupRouter(server, authenticator, cfg, logger)
 
 	startServer(router, ":8080", logger)
 }
 
-func setupRouter(apiHandler *api.Server, logger *log.Logger) *chi.Mux {
+func setupRouter(apiHandler *api.Server, authenticator auth.Authenticator, cfg *config.Config, logger *log.Logger) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Базовые middleware
@@ -69,15 +76,26 @@ func setupRouter(apiHandler *api.Server, logger *log.Logger) *chi.Mux {
 		// AllowedOrigins:   []string{"https://foo.com"}, // Use this to allow specific origin hosts
 		AllowedOrigins: []string{"https://*", "http://*"},
 		// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", auth.APIKeyHeader},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: false,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	}))
 
+	// Пробы для оркестратора доступны и без префикса версии
+	r.Get("/health", apiHandler.GetHealth)
+	r.Get("/ready", apiHandler.GetReady)
+
+	// Локальное хранилище раздаём сами, в проде ссылки ведут прямо в MinIO
+	if cfg.Storage.Driver == config.StorageDriverLocal {
+		r.Handle("/files/*", http.StripPrefix("/files/", filestorage.LocalFileServer(cfg.Storage.LocalDir)))
+	}
+
 	r.Route("/api/v1", func(r chi.Router) {
-		r.Mount("/", generated.Handler(apiHandler))
+		r.Mount("/", generated.HandlerWithOptions(apiHandler, generated.ChiServerOptions{
+			Middlewares: []generated.MiddlewareFunc{api.Authorize(authenticator)},
+		}))
 	})
 
 	return r
@@ -120,6 +138,26 @@ func startServer(handler http.Handler, addr string, logger *log.Logger) {
 	logger.Println("Server stopped")
 }
 
+func setupAuth(cfg *config.Config, logger *log.Logger) auth.Authenticator {
+	opts := auth.Options{
+		JWTAlgorithm: cfg.Auth.JWTAlgorithm,
+		JWTKey:       cfg.Auth.JWTKey,
+		JWTIssuer:    cfg.Auth.JWTIssuer,
+	}
+	for _, key := range cfg.Auth.APIKeys {
+		opts.APIKeys = append(opts.APIKeys, auth.APIKey{Name: key.Name, Role: auth.Role(key.Role), Key: key.Key})
+	}
+
+	authenticator, err := auth.NewAuthenticator(opts)
+	if err != nil {
+		log.Fatalf("Failed to setup auth: %v", err)
+	}
+	if opts.JWTAlgorithm == "" && len(opts.APIKeys) == 0 {
+		logger.Println("Warning: neither AUTH_JWT_ALGORITHM nor AUTH_API_KEYS is set, write endpoints are closed")
+	}
+	return authenticator
+}
+
 func setupDbStorage(ctx context.Context, cfg *config.Config) *dbstorage.Storage {
 	storage, err := dbstorage.New(ctx, cfg.Postgres.DSN)
 	if err != nil {
@@ -129,7 +167,16 @@ func setupDbStorage(ctx context.Context, cfg *config.Config) *dbstorage.Storage
 	return storage
 }
 
-func setupMinioStorage(ctx context.Context, cfg *config.Config) (filestorage.FileStorage, error) {
+func setupFileStorage(ctx context.Context, cfg *config.Config) (filestorage.FileStorage, error) {
+	if cfg.Storage.Driver == config.StorageDriverLocal {
+		fileStore, err := filestorage.NewLocalStorage(cfg.Storage.LocalDir, cfg.Storage.LocalPublicURL)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Using local file storage in %s", cfg.Storage.LocalDir)
+		return fileStore, nil
+	}
+
 	fileStore, err := filestorage.NewMinioStorage(ctx,
 		cfg.Minio.Endpoint,
 		cfg.Minio.ServerURL,
@@ -137,6 +184,7 @@ func setupMinioStorage(ctx context.Context, cfg *config.Config) (filestorage.Fil
 		cfg.Minio.SecretKey,
 		cfg.Minio.Bucket,
 		false,
+		cfg.Minio.URLExpiry,
 	)
 	if err != nil {
 		log.Printf("Warning: Failed to initialize file storage: %v", err)
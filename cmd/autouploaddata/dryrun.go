@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
+)
+
+// dryRunStorage подменяет хранилище при --dry-run: файлы читаются, но никуда не загружаются
+type dryRunStorage struct{}
+
+var _ filestorage.FileStorage = dryRunStorage{}
+
+func (dryRunStorage) UploadFile(_ context.Context, originalName string, reader io.Reader, _ int64, _ string) (string, error) {
+	// Дочитываем файл, чтобы ошибки чтения проявились так же, как при настоящей загрузке.
+	// Ключ тот же, что дало бы хранилище, иначе отчёт показал бы изменёнными все файлы
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return filestorage.ContentKey(data, originalName), nil
+}
+
+func (dryRunStorage) GetFileURL(fileKey string) (string, error) {
+	return "", nil
+}
+
+func (dryRunStorage) GetDownloadURL(context.Context, string) (string, error) {
+	return "", nil
+}
+
+func (dryRunStorage) OpenFile(context.Context, string) (*filestorage.Object, error) {
+	return nil, errors.New("dry run storage does not keep files")
+}
+
+func (dryRunStorage) DeleteFile(context.Context, string) error {
+	return nil
+}
+
+func (dryRunStorage) GetOriginalName(_ context.Context, fileKey string) (string, error) {
+	return fileKey, nil
+}
+
+func (dryRunStorage) ListFiles(context.Context) ([]filestorage.FileInfo, error) {
+	return nil, nil
+}
+
+func (dryRunStorage) Ping(context.Context) error {
+	return nil
+}
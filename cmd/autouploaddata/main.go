@@ -2,7 +2,12 @@ package main
 
 import (
 	"context"
+	"flag"
+	"io"
 	"log"
+	"net/http"
+	"os"
+	"time"
 
 	"github.com/Ari-Pari/backend/internal/clients/dbstorage"
 	"github.com/Ari-Pari/backend/internal/clients/filestorage"
@@ -10,18 +15,44 @@ import (
 	db "github.com/Ari-Pari/backend/internal/db/sqlc"
 	"github.com/Ari-Pari/backend/internal/parser"
 	"github.com/Ari-Pari/backend/internal/services/autoUploadDataService"
+	"github.com/Ari-Pari/backend/internal/services/photoVariantService"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 )
 
+const (
+	statesFile  = "static/autouploaddata/states.json"
+	artistsFile = "static/autouploaddata/artists.json"
+	dancesFile  = "static/autouploaddata/dances.json"
+	musicsFile  = "static/autouploaddata/musics.json"
+	videosFile  = "static/autouploaddata/videos.json"
+	groupsFile  = "static/autouploaddata/groups.json"
+
+	webpQuality = 80
+)
+
 func main() {
+	truncate := flag.Bool("truncate", false, "очистить все таблицы перед загрузкой (сбрасывает popularity и id)")
+	dryRun := flag.Bool("dry-run", false, "только показать отличия от базы: ничего не загружать в хранилище и не писать в Postgres")
+	lenient := flag.Bool("lenient", false, "продолжать загрузку, даже если проверка исходных файлов нашла проблемы")
+	allowMissingMedia := flag.Bool("allow-missing-media", false, "загружать танцы и песни без найденных фото и аудио, отмечая их media_status")
+	reportFormat := flag.String("report-format", "text", "формат отчётов о проблемах в исходных файлах и медиа: text или json")
+	flag.Parse()
+
+	if *reportFormat != "text" && *reportFormat != "json" {
+		log.Fatalf("Unknown --report-format %q, expected text or json", *reportFormat)
+	}
+
 	_ = godotenv.Load()
 	ctx := context.Background()
 	cfg, err := config.Load()
 
 	setupDbStorage(ctx, cfg.PostgresAutoUpload.DSN)
 
-	fileStore := setupMinioStorage(ctx, cfg)
+	var fileStore filestorage.FileStorage = dryRunStorage{}
+	if !*dryRun {
+		fileStore = setupFileStorage(ctx, cfg)
+	}
 
 	conn, err := pgxpool.New(ctx, cfg.PostgresAutoUpload.DSN)
 	if err != nil {
@@ -30,82 +61,155 @@ func main() {
 
 	myParser := parser.NewJSONParser()
 
-	service := autoUploadDataService.NewAutoUploadDataService(db.New(conn))
-
-	states, err := myParser.ParseStatesFile("static/autouploaddata/states.json")
+	// Сначала разбираем все файлы и проверяем их целиком, затем загружаем медиа,
+	// чтобы транзакция с базой была короткой
+	states, err := myParser.ParseStatesFile(statesFile)
 
 	if err != nil {
 		log.Fatal("Failed to parse states:", err)
 	}
 
-	err = service.ClearAllTables(ctx)
+	artists, err := myParser.ParseArtistsFile(artistsFile)
 
 	if err != nil {
-		log.Fatal("Failed to clear all tables:", err)
+		log.Fatal("Failed to parse artists:", err)
 	}
 
-	regions := parser.ToDomainRegions(states)
+	dances, err := myParser.ParseDancesFile(dancesFile)
 
-	err = service.CreateRegions(ctx, regions)
 	if err != nil {
-		log.Fatal("Failed to create regions:", err)
+		log.Fatal("Failed to parse dances:", err)
 	}
 
-	artists, err := myParser.ParseArtistsFile("static/autouploaddata/artists.json")
+	musics, err := myParser.ParseMusicsFile(musicsFile)
 
 	if err != nil {
-		log.Fatal("Failed to parse states:", err)
+		log.Fatal("Failed to parse musics:", err)
 	}
 
-	domainArtists := parser.ToDomainArtists(artists)
+	videos, err := myParser.ParseVideosFile(videosFile)
 
-	err = service.CreateArtists(ctx, domainArtists)
 	if err != nil {
-		log.Fatal("Failed to create artists:", err)
+		log.Fatal("Failed to parse videos:", err)
 	}
 
-	dances, err := myParser.ParseDancesFile("static/autouploaddata/dances.json")
+	groups, err := myParser.ParseGroupsFile(groupsFile)
 
 	if err != nil {
-		log.Fatal("Failed to parse dances:", err)
+		log.Fatal("Failed to parse groups:", err)
+	}
+
+	validation := parser.Validate(parser.SourceFiles{
+		StatesFile:  statesFile,
+		ArtistsFile: artistsFile,
+		DancesFile:  dancesFile,
+		MusicsFile:  musicsFile,
+		VideosFile:  videosFile,
+		GroupsFile:  groupsFile,
+		States:      states,
+		Artists:     artists,
+		Dances:      dances,
+		Musics:      musics,
+		Videos:      videos,
+		Groups:      groups,
+	})
+	if validation.HasProblems() {
+		if err = writeReport(validation, *reportFormat); err != nil {
+			log.Fatal("Failed to print validation report:", err)
+		}
+		if !*lenient {
+			log.Fatal("Source data is invalid, fix the problems above or run with --lenient")
+		}
 	}
 
-	domainDances, err := parser.ToDomainDances(ctx, fileStore, parser.DefaultFileReader, dances, cfg.DancePhotosFolderPath)
+	regions := parser.ToDomainRegions(states)
+
+	domainArtists := parser.ToDomainArtists(artists)
+
+	domainDances, mediaReport, err := parser.ToDomainDances(ctx, setupPhotoVariants(cfg, fileStore), parser.DefaultFileReader, dances, cfg.DancePhotosFolderPath)
 
 	if err != nil {
 		log.Fatal("Failed to parse dance files: ", err)
 	}
 
-	err = service.CreateDances(ctx, domainDances)
+	domainSongs, songsMediaReport, err := parser.ToDomainSongs(ctx, fileStore, setupMusicSource(cfg), musics)
+
 	if err != nil {
-		log.Fatal("Failed to create dances:", err)
+		log.Fatal("Failed to parse song files: ", err)
 	}
 
-	musics, err := myParser.ParseMusicsFile("static/autouploaddata/musics.json")
-
-	if err != nil {
-		log.Fatal("Failed to parse musics:", err)
+	mediaReport.Merge(songsMediaReport)
+	if mediaReport.HasProblems() {
+		if err = writeReport(&mediaReport, *reportFormat); err != nil {
+			log.Fatal("Failed to print media report:", err)
+		}
+		// В dry-run отчёт только показывается, чтобы можно было посмотреть и отличия от базы
+		if !*allowMissingMedia && !*dryRun {
+			log.Fatal("Some photos or audio files are missing or unreadable, run with --allow-missing-media to import them with media_status set")
+		}
 	}
 
-	domainSongs := parser.ToDomainSongs(ctx, fileStore, parser.DefaultFileReader, musics, cfg.MusicFolderPath)
+	// Видео из videos.json дополняются ссылками из карточек танцев без дублей
+	domainVideos := parser.MergeVideos(parser.ToDomainVideos(videos), parser.ToDomainDanceVideos(dances))
 
-	err = service.CreateSongs(ctx, domainSongs)
-	if err != nil {
-		log.Fatal("Failed to create songs:", err)
-	}
+	domainGroups := parser.ToDomainGroups(groups)
 
-	videos, err := myParser.ParseVideosFile("static/autouploaddata/videos.json")
+	data := autoUploadDataService.ImportData{
+		Regions: regions,
+		Artists: domainArtists,
+		Dances:  domainDances,
+		Songs:   domainSongs,
+		Videos:  domainVideos,
+		Groups:  domainGroups,
+	}
 
-	if err != nil {
-		log.Fatal("Failed to parse videos:", err)
+	if *dryRun {
+		service := autoUploadDataService.NewAutoUploadDataService(db.New(conn))
+		report, err := service.Diff(ctx, data)
+		if err != nil {
+			log.Fatal("Failed to build diff report:", err)
+		}
+		if err = report.WriteText(os.Stdout); err != nil {
+			log.Fatal("Failed to print diff report:", err)
+		}
+		return
 	}
 
-	domainVideos := parser.ToDomainVideos(videos)
+	var steps []autoUploadDataService.ImportStep
+	// По умолчанию данные обновляются на месте, полная перезаливка только по флагу
+	if *truncate {
+		steps = append(steps, autoUploadDataService.ImportStep{Name: "truncate", Run: func(ctx context.Context, s autoUploadDataService.AutoUploadDataService) error {
+			return s.ClearAllTables(ctx)
+		}})
+	}
+	steps = append(steps,
+		autoUploadDataService.ImportStep{Name: "regions", Run: func(ctx context.Context, s autoUploadDataService.AutoUploadDataService) error {
+			return s.UpsertRegions(ctx, regions)
+		}},
+		autoUploadDataService.ImportStep{Name: "artists", Run: func(ctx context.Context, s autoUploadDataService.AutoUploadDataService) error {
+			return s.UpsertArtists(ctx, domainArtists)
+		}},
+		autoUploadDataService.ImportStep{Name: "dances", Run: func(ctx context.Context, s autoUploadDataService.AutoUploadDataService) error {
+			return s.UpsertDances(ctx, domainDances)
+		}},
+		autoUploadDataService.ImportStep{Name: "songs", Run: func(ctx context.Context, s autoUploadDataService.AutoUploadDataService) error {
+			return s.UpsertSongs(ctx, domainSongs)
+		}},
+		autoUploadDataService.ImportStep{Name: "videos", Run: func(ctx context.Context, s autoUploadDataService.AutoUploadDataService) error {
+			return s.UpsertVideos(ctx, domainVideos)
+		}},
+		autoUploadDataService.ImportStep{Name: "groups", Run: func(ctx context.Context, s autoUploadDataService.AutoUploadDataService) error {
+			return s.UpsertGroups(ctx, domainGroups)
+		}},
+	)
 
-	err = service.CreateVideos(ctx, domainVideos)
+	// Все шаги идут в одной транзакции: при ошибке база остаётся в прежнем состоянии
+	runID, err := autoUploadDataService.RunImport(ctx, conn, db.New(conn), data, steps)
 	if err != nil {
-		log.Fatal("Failed to create videos:", err)
+		log.Fatal("Import failed, all changes rolled back: ", err)
 	}
+
+	log.Printf("Import finished successfully, audit log import run id %s", runID)
 }
 
 func setupDbStorage(ctx context.Context, dsn string) {
@@ -117,7 +221,15 @@ func setupDbStorage(ctx context.Context, dsn string) {
 	log.Println("Successfully connected to the database!")
 }
 
-func setupMinioStorage(ctx context.Context, cfg *config.Config) filestorage.FileStorage {
+func setupFileStorage(ctx context.Context, cfg *config.Config) filestorage.FileStorage {
+	if cfg.Storage.Driver == config.StorageDriverLocal {
+		fileStore, err := filestorage.NewLocalStorage(cfg.Storage.LocalDir, cfg.Storage.LocalPublicURL)
+		if err != nil {
+			log.Fatalf("Failed to initialize local file storage: %v", err)
+		}
+		return fileStore
+	}
+
 	fileStore, err := filestorage.NewMinioStorage(
 		ctx,
 		cfg.Minio.ServerURL,
@@ -126,6 +238,7 @@ func setupMinioStorage(ctx context.Context, cfg *config.Config) filestorage.File
 		cfg.Minio.SecretKey,
 		cfg.Minio.Bucket,
 		false,
+		cfg.Minio.URLExpiry,
 	)
 	if err != nil {
 		log.Printf("Warning: Failed to initialize file storage: %v", err)
@@ -135,3 +248,39 @@ func setupMinioStorage(ctx context.Context, cfg *config.Config) filestorage.File
 	}
 	return fileStore
 }
+
+// setupPhotoVariants включает WebP-копии фото, только если найден cwebp
+func setupPhotoVariants(cfg *config.Config, fileStore filestorage.FileStorage) photoVariantService.PhotoVariantService {
+	webp, err := photoVariantService.NewCWebPEncoder(cfg.CWebPPath, webpQuality)
+	if err != nil {
+		log.Printf("Warning: %v, photo variants will be JPEG only", err)
+		return photoVariantService.NewPhotoVariantService(fileStore, nil)
+	}
+	return photoVariantService.NewPhotoVariantService(fileStore, webp)
+}
+
+// reportWriter — отчёт о проблемах в исходных данных, который можно напечатать текстом или JSON
+type reportWriter interface {
+	WriteText(w io.Writer) error
+	WriteJSON(w io.Writer) error
+}
+
+// writeReport печатает JSON в stdout, чтобы его можно было передать дальше, а текст — в stderr рядом с логами
+func writeReport(report reportWriter, format string) error {
+	if format == "json" {
+		return report.WriteJSON(os.Stdout)
+	}
+	return report.WriteText(os.Stderr)
+}
+
+// setupMusicSource ищет аудио сначала по названию, затем по fileUniqueId и в последнюю очередь скачивает из Telegram
+func setupMusicSource(cfg *config.Config) parser.MediaSource {
+	sources := []parser.MediaSource{parser.NewFolderMediaSource(parser.DefaultFileReader, cfg.MusicFolderPath)}
+	if cfg.MusicFileIdFolderPath != "" {
+		sources = append(sources, parser.NewFileUniqueIDMediaSource(parser.DefaultFileReader, cfg.MusicFileIdFolderPath))
+	}
+	if cfg.Telegram.BotToken != "" {
+		sources = append(sources, parser.NewTelegramMediaSource(&http.Client{Timeout: time.Minute}, cfg.Telegram.APIURL, cfg.Telegram.BotToken))
+	}
+	return parser.NewChainMediaSource(sources...)
+}
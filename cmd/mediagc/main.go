@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
+	"github.com/Ari-Pari/backend/internal/config"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/services/mediaGCService"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	minAge := flag.Duration("min-age", 24*time.Hour, "не трогать объекты моложе этого возраста, чтобы не задеть идущий импорт")
+	dryRun := flag.Bool("dry-run", false, "только показать объекты без ссылок из базы, ничего не удаляя")
+	flag.Parse()
+
+	_ = godotenv.Load()
+	ctx := context.Background()
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	conn, err := pgxpool.New(ctx, cfg.PostgresAutoUpload.DSN)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	defer conn.Close()
+
+	var fileStore filestorage.FileStorage
+	if cfg.Storage.Driver == config.StorageDriverLocal {
+		fileStore, err = filestorage.NewLocalStorage(cfg.Storage.LocalDir, cfg.Storage.LocalPublicURL)
+	} else {
+		fileStore, err = filestorage.NewMinioStorage(
+			ctx,
+			cfg.Minio.ServerURL,
+			cfg.Minio.ServerURL,
+			cfg.Minio.AccessKey,
+			cfg.Minio.SecretKey,
+			cfg.Minio.Bucket,
+			false,
+			cfg.Minio.URLExpiry,
+		)
+	}
+	if err != nil {
+		log.Fatalf("Failed to initialize file storage: %v", err)
+	}
+
+	service := mediaGCService.NewMediaGCService(db.New(conn), fileStore)
+	report, err := service.Collect(ctx, mediaGCService.Options{MinAge: *minAge, DryRun: *dryRun})
+	if report != nil {
+		if writeErr := report.WriteText(os.Stdout); writeErr != nil {
+			log.Fatal("Failed to print report:", writeErr)
+		}
+	}
+	if err != nil {
+		log.Fatal("Media garbage collection failed: ", err)
+	}
+}
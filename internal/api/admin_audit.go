@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/audit"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+)
+
+func (s *Server) GetAdminAudit(w http.ResponseWriter, r *http.Request, params api.GetAdminAuditParams) {
+	filter := adminService.AuditFilter{
+		Actor:       deref(params.Actor),
+		ImportRunID: deref(params.ImportRunId),
+		From:        params.From,
+		To:          params.To,
+		Page:        deref(params.Page),
+		Size:        deref(params.Size),
+	}
+	if params.EntityType != nil {
+		filter.EntityType = string(*params.EntityType)
+	}
+	if params.EntityId != nil {
+		entityID := int64(*params.EntityId)
+		filter.EntityID = &entityID
+	}
+	if params.Action != nil {
+		filter.Action = audit.Action(*params.Action)
+	}
+
+	entries, err := s.admin.ListAudit(r.Context(), filter)
+	if err != nil {
+		s.writeAdminError(w, err, "list audit")
+		return
+	}
+
+	res := make(api.AuditLogResponse, len(entries))
+	for i, entry := range entries {
+		res[i] = toAuditEntryResponse(entry)
+	}
+	s.writeJSON(w, http.StatusOK, res)
+}
+
+func toAuditEntryResponse(entry audit.Entry) api.AuditEntryResponse {
+	res := api.AuditEntryResponse{
+		Id:         entry.ID,
+		CreatedAt:  entry.CreatedAt,
+		Actor:      entry.Actor,
+		EntityType: api.AuditEntityType(entry.EntityType),
+		EntityId:   entry.EntityID,
+		Action:     api.AuditAction(entry.Action),
+		Diff:       make(map[string]api.AuditChange, len(entry.Diff)),
+	}
+	for field, change := range entry.Diff {
+		res.Diff[field] = api.AuditChange{Old: change.Old, New: change.New}
+	}
+	if entry.ImportRunID != "" {
+		res.ImportRunId = &entry.ImportRunID
+	}
+	return res
+}
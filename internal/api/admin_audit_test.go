@@ -0,0 +1,116 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/auth"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminAudit_Integration(t *testing.T) {
+	clearTables(t)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{}).WithAdmin(adminService.NewAdminService(testDBPool, queries, &mockStorage{}))
+
+	// Правки идут от имени пользователя, которого проверка прав кладёт в контекст
+	asEditor := func(req *http.Request) *http.Request {
+		principal := auth.Principal{Subject: "editor@example.com", Roles: []auth.Role{auth.RoleEditor}}
+		return req.WithContext(auth.WithPrincipal(req.Context(), principal))
+	}
+
+	w := httptest.NewRecorder()
+	srv.PostAdminDances(w, asEditor(httptest.NewRequest(http.MethodPost, "/api/v1/admin/dances", jsonBody(t, api.AdminDanceRequest{
+		Names:  api.Names{Hy: "Բերդ"},
+		Gender: api.DanceGenderMULTY,
+		Paces:  &[]int{1},
+	}))))
+	require.Equal(t, http.StatusCreated, w.Code)
+	var dance api.AdminDanceResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &dance))
+	path := "/api/v1/admin/dances/" + strconv.Itoa(dance.Id)
+
+	w = httptest.NewRecorder()
+	srv.PatchAdminDancesId(w, asEditor(httptest.NewRequest(http.MethodPatch, path, jsonBody(t, api.AdminDancePatchRequest{
+		Paces: &[]int{1, 2},
+	}))), dance.Id)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// Правка без изменений и отклонённая правка в журнал не попадают
+	w = httptest.NewRecorder()
+	srv.PatchAdminDancesId(w, asEditor(httptest.NewRequest(http.MethodPatch, path, jsonBody(t, api.AdminDancePatchRequest{
+		Paces: &[]int{2, 1},
+	}))), dance.Id)
+	require.Equal(t, http.StatusOK, w.Code)
+	w = httptest.NewRecorder()
+	srv.PatchAdminDancesId(w, asEditor(httptest.NewRequest(http.MethodPatch, path, jsonBody(t, api.AdminDancePatchRequest{
+		Paces: &[]int{9},
+	}))), dance.Id)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	srv.DeleteAdminDancesId(w, asEditor(httptest.NewRequest(http.MethodDelete, path, nil)), dance.Id)
+	require.Equal(t, http.StatusNoContent, w.Code)
+
+	t.Run("History Of One Dance", func(t *testing.T) {
+		entityType := api.Dance
+		w := httptest.NewRecorder()
+
+		srv.GetAdminAudit(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit", nil), api.GetAdminAuditParams{
+			EntityType: &entityType,
+			EntityId:   &dance.Id,
+		})
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.AuditLogResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 3)
+
+		assert.Equal(t, api.Delete, response[0].Action)
+		assert.Equal(t, map[string]api.AuditChange{"deleted": {Old: false, New: true}}, response[0].Diff)
+
+		assert.Equal(t, api.Update, response[1].Action)
+		assert.Equal(t, "editor@example.com", response[1].Actor)
+		assert.Equal(t, map[string]api.AuditChange{"paces": {Old: []any{1.0}, New: []any{1.0, 2.0}}}, response[1].Diff)
+		assert.Nil(t, response[1].ImportRunId)
+
+		assert.Equal(t, api.Create, response[2].Action)
+		assert.Equal(t, "Բերդ", response[2].Diff["names.hy"].New)
+		assert.Nil(t, response[2].Diff["names.hy"].Old)
+	})
+
+	t.Run("Filter By Action", func(t *testing.T) {
+		action := api.Update
+		w := httptest.NewRecorder()
+
+		srv.GetAdminAudit(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit?action=update", nil), api.GetAdminAuditParams{Action: &action})
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.AuditLogResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+	})
+
+	t.Run("400 - Bad Import Run Id", func(t *testing.T) {
+		runID := "not-a-uuid"
+		w := httptest.NewRecorder()
+
+		srv.GetAdminAudit(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit?importRunId=not-a-uuid", nil), api.GetAdminAuditParams{ImportRunId: &runID})
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		var response api.ValidationErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, []api.FieldProblem{{Field: "importRunId", Reason: "is not a valid UUID"}}, response.Problems)
+	})
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+)
+
+func (s *Server) GetAdminDancesIdRevisions(w http.ResponseWriter, r *http.Request, id int) {
+	revisions, err := s.admin.ListDanceRevisions(r.Context(), int64(id))
+	if err != nil {
+		s.writeAdminError(w, err, "list dance revisions")
+		return
+	}
+
+	res := make(api.DanceRevisionListResponse, len(revisions))
+	for i, revision := range revisions {
+		res[i] = toDanceRevisionResponse(revision)
+	}
+	s.writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) PostAdminDancesIdRevisionsRevRestore(w http.ResponseWriter, r *http.Request, id int, rev int) {
+	revision, err := s.admin.RestoreDanceRevision(r.Context(), int64(id), int32(rev))
+	if err != nil {
+		s.writeAdminError(w, err, "restore dance revision")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toDanceRevisionResponse(revision))
+}
+
+func toDanceRevisionResponse(revision adminService.DanceRevision) api.DanceRevisionResponse {
+	res := api.DanceRevisionResponse{
+		Rev:       int(revision.Rev),
+		CreatedAt: revision.CreatedAt,
+		Dance:     toAdminDanceResponse(revision.Dance),
+		SongIds:   make([]int, len(revision.SongIds)),
+		VideoIds:  make([]int, len(revision.VideoIds)),
+		Deleted:   revision.Deleted,
+	}
+	for i, songID := range revision.SongIds {
+		res.SongIds[i] = int(songID)
+	}
+	for i, videoID := range revision.VideoIds {
+		res.VideoIds[i] = int(videoID)
+	}
+	if revision.Actor != "" {
+		res.Actor = &revision.Actor
+	}
+	if revision.ImportRunID != "" {
+		res.ImportRunId = &revision.ImportRunID
+	}
+	return res
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/auth"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminDanceRevisions_Integration(t *testing.T) {
+	clearTables(t)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{}).WithAdmin(adminService.NewAdminService(testDBPool, queries, &mockStorage{}))
+
+	asEditor := func(req *http.Request) *http.Request {
+		principal := auth.Principal{Subject: "editor@example.com", Roles: []auth.Role{auth.RoleEditor}}
+		return req.WithContext(auth.WithPrincipal(req.Context(), principal))
+	}
+
+	// rev 1 — создание, rev 2 — правка, rev 3 — привязка видео, rev 4 — удаление
+	w := httptest.NewRecorder()
+	srv.PostAdminDances(w, asEditor(httptest.NewRequest(http.MethodPost, "/api/v1/admin/dances", jsonBody(t, api.AdminDanceRequest{
+		Names:  api.Names{Hy: "Բերդ"},
+		Gender: api.DanceGenderMULTY,
+		Paces:  &[]int{1},
+	}))))
+	require.Equal(t, http.StatusCreated, w.Code)
+	var dance api.AdminDanceResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &dance))
+	path := "/api/v1/admin/dances/" + strconv.Itoa(dance.Id)
+
+	w = httptest.NewRecorder()
+	srv.PatchAdminDancesId(w, asEditor(httptest.NewRequest(http.MethodPatch, path, jsonBody(t, api.AdminDancePatchRequest{
+		Paces: &[]int{1, 2},
+	}))), dance.Id)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	srv.PostAdminVideos(w, asEditor(httptest.NewRequest(http.MethodPost, "/api/v1/admin/videos", jsonBody(t, api.AdminVideoRequest{
+		Names:    api.Names{Hy: "Բերդ դաս"},
+		Link:     "https://youtu.be/Wk61XUxP2Mg",
+		Type:     api.LESSON,
+		DanceIds: &[]int{dance.Id},
+	}))))
+	require.Equal(t, http.StatusCreated, w.Code)
+	var video api.AdminVideoResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &video))
+
+	w = httptest.NewRecorder()
+	srv.DeleteAdminDancesId(w, asEditor(httptest.NewRequest(http.MethodDelete, path, nil)), dance.Id)
+	require.Equal(t, http.StatusNoContent, w.Code)
+
+	t.Run("List", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		srv.GetAdminDancesIdRevisions(w, httptest.NewRequest(http.MethodGet, path+"/revisions", nil), dance.Id)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.DanceRevisionListResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 4)
+
+		assert.Equal(t, 4, response[0].Rev)
+		assert.True(t, response[0].Deleted)
+		assert.Equal(t, []int{video.Id}, response[1].VideoIds)
+		assert.Equal(t, []int{1, 2}, response[2].Dance.Paces)
+		assert.Equal(t, []int{1}, response[3].Dance.Paces)
+		assert.Empty(t, response[3].VideoIds)
+		assert.Equal(t, "editor@example.com", *response[3].Actor)
+	})
+
+	t.Run("Restore 200 - Undeleted With Links", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		srv.PostAdminDancesIdRevisionsRevRestore(w, asEditor(httptest.NewRequest(http.MethodPost, path+"/revisions/3/restore", nil)), dance.Id, 3)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.DanceRevisionResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 5, response.Rev)
+		assert.False(t, response.Deleted)
+		assert.Equal(t, []int{1, 2}, response.Dance.Paces)
+		assert.Equal(t, []int{video.Id}, response.VideoIds)
+
+		w = httptest.NewRecorder()
+		srv.GetAdminDancesId(w, httptest.NewRequest(http.MethodGet, path, nil), dance.Id)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		action := api.Restore
+		w = httptest.NewRecorder()
+		srv.GetAdminAudit(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit?action=restore", nil), api.GetAdminAuditParams{Action: &action})
+		require.Equal(t, http.StatusOK, w.Code)
+		var entries api.AuditLogResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &entries))
+		require.Len(t, entries, 1)
+		assert.Equal(t, api.AuditChange{Old: true, New: false}, entries[0].Diff["deleted"])
+	})
+
+	t.Run("Restore 404 - Unknown Revision", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		srv.PostAdminDancesIdRevisionsRevRestore(w, asEditor(httptest.NewRequest(http.MethodPost, path+"/revisions/99/restore", nil)), dance.Id, 99)
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("List 404 - Unknown Dance", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		srv.GetAdminDancesIdRevisions(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/dances/999/revisions", nil), 999)
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAdminDanceRevisions_ConcurrentEdits_Integration(t *testing.T) {
+	clearTables(t)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{}).WithAdmin(adminService.NewAdminService(testDBPool, queries, &mockStorage{}))
+
+	w := httptest.NewRecorder()
+	srv.PostAdminDances(w, httptest.NewRequest(http.MethodPost, "/api/v1/admin/dances", jsonBody(t, api.AdminDanceRequest{
+		Names:  api.Names{Hy: "Բերդ"},
+		Gender: api.DanceGenderMULTY,
+	})))
+	require.Equal(t, http.StatusCreated, w.Code)
+	var dance api.AdminDanceResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &dance))
+	path := "/api/v1/admin/dances/" + strconv.Itoa(dance.Id)
+
+	// Правка танца и привязка к нему видео пишут версии одного танца одновременно
+	const n = 10
+	codes := make(chan int, 2*n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			w := httptest.NewRecorder()
+			srv.PatchAdminDancesId(w, httptest.NewRequest(http.MethodPatch, path, jsonBody(t, api.AdminDancePatchRequest{
+				Paces: &[]int{i%3 + 1},
+			})), dance.Id)
+			codes <- w.Code
+		}()
+		go func() {
+			defer wg.Done()
+			w := httptest.NewRecorder()
+			srv.PostAdminVideos(w, httptest.NewRequest(http.MethodPost, "/api/v1/admin/videos", jsonBody(t, api.AdminVideoRequest{
+				Names:    api.Names{Hy: "Տեսանյութ"},
+				Link:     fmt.Sprintf("https://youtu.be/video%06d", i),
+				Type:     api.VIDEO,
+				DanceIds: &[]int{dance.Id},
+			})))
+			codes <- w.Code
+		}()
+	}
+	wg.Wait()
+	close(codes)
+	for code := range codes {
+		assert.Contains(t, []int{http.StatusOK, http.StatusCreated}, code)
+	}
+
+	w = httptest.NewRecorder()
+	srv.GetAdminDancesIdRevisions(w, httptest.NewRequest(http.MethodGet, path+"/revisions", nil), dance.Id)
+	require.Equal(t, http.StatusOK, w.Code)
+	var revisions api.DanceRevisionListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &revisions))
+	assert.Len(t, revisions[0].VideoIds, n)
+	for i, revision := range revisions {
+		assert.Equal(t, len(revisions)-i, revision.Rev)
+	}
+}
@@ -0,0 +1,250 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+)
+
+func (s *Server) GetAdminDancesId(w http.ResponseWriter, r *http.Request, id int) {
+	dance, err := s.admin.GetDance(r.Context(), int64(id))
+	if err != nil {
+		s.writeAdminError(w, err, "get dance")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminDanceResponse(dance))
+}
+
+func (s *Server) PostAdminDances(w http.ResponseWriter, r *http.Request) {
+	var req api.AdminDanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dance, err := s.admin.CreateDance(r.Context(), danceFromRequest(req))
+	if err != nil {
+		s.writeAdminError(w, err, "create dance")
+		return
+	}
+
+	w.Header().Set("Location", "/api/v1/admin/dances/"+strconv.FormatInt(dance.Id, 10))
+	s.writeJSON(w, http.StatusCreated, toAdminDanceResponse(dance))
+}
+
+func (s *Server) PutAdminDancesId(w http.ResponseWriter, r *http.Request, id int) {
+	var req api.AdminDanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dance, err := s.admin.UpdateDance(r.Context(), int64(id), func(dance *domain.DanceShort) {
+		*dance = danceFromRequest(req)
+	})
+	if err != nil {
+		s.writeAdminError(w, err, "update dance")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminDanceResponse(dance))
+}
+
+func (s *Server) PatchAdminDancesId(w http.ResponseWriter, r *http.Request, id int) {
+	var req api.AdminDancePatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dance, err := s.admin.UpdateDance(r.Context(), int64(id), func(dance *domain.DanceShort) {
+		applyDancePatch(dance, req)
+	})
+	if err != nil {
+		s.writeAdminError(w, err, "patch dance")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminDanceResponse(dance))
+}
+
+func (s *Server) DeleteAdminDancesId(w http.ResponseWriter, r *http.Request, id int) {
+	if err := s.admin.DeleteDance(r.Context(), int64(id)); err != nil {
+		s.writeAdminError(w, err, "delete dance")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeAdminError отвечает 400 со списком ошибок полей, 404 для ненайденной записи и 500 для остального
+func (s *Server) writeAdminError(w http.ResponseWriter, err error, action string) {
+	var validationErr *adminService.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		res := api.ValidationErrorResponse{Problems: make([]api.FieldProblem, len(validationErr.Problems))}
+		for i, p := range validationErr.Problems {
+			res.Problems[i] = api.FieldProblem{Field: p.Field, Reason: p.Reason}
+		}
+		s.writeJSON(w, http.StatusBadRequest, res)
+	case errors.Is(err, adminService.ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		s.logger.Printf("db error (admin %s): %v", action, err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
+
+func danceFromRequest(req api.AdminDanceRequest) domain.DanceShort {
+	dance := domain.DanceShort{
+		Name:   fromNames(req.Names),
+		Gender: domain.Gender(req.Gender),
+	}
+	if req.Complexity != nil {
+		complexity := int32(*req.Complexity)
+		dance.Complexity = &complexity
+	}
+	if req.Paces != nil {
+		dance.Paces = toInt32s(*req.Paces)
+	}
+	if req.Genres != nil {
+		dance.Genres = toGenres(*req.Genres)
+	}
+	if req.Handshakes != nil {
+		dance.HoldingTypes = toHoldingTypes(*req.Handshakes)
+	}
+	if req.RegionIds != nil {
+		dance.RegionIds = toInt64s(*req.RegionIds)
+	}
+	return dance
+}
+
+// applyDancePatch меняет только поля, которые пришли в запросе
+func applyDancePatch(dance *domain.DanceShort, req api.AdminDancePatchRequest) {
+	applyNamesPatch(&dance.Name, req.Names)
+	if req.Complexity != nil {
+		complexity := int32(*req.Complexity)
+		dance.Complexity = &complexity
+	}
+	if req.Gender != nil {
+		dance.Gender = domain.Gender(*req.Gender)
+	}
+	if req.Paces != nil {
+		dance.Paces = toInt32s(*req.Paces)
+	}
+	if req.Genres != nil {
+		dance.Genres = toGenres(*req.Genres)
+	}
+	if req.Handshakes != nil {
+		dance.HoldingTypes = toHoldingTypes(*req.Handshakes)
+	}
+	if req.RegionIds != nil {
+		dance.RegionIds = toInt64s(*req.RegionIds)
+	}
+}
+
+func toAdminDanceResponse(dance domain.DanceShort) api.AdminDanceResponse {
+	res := api.AdminDanceResponse{
+		Id:         int(dance.Id),
+		Names:      toNames(dance.Name),
+		Gender:     api.DanceGender(dance.Gender),
+		Paces:      make([]int, len(dance.Paces)),
+		Genres:     make([]api.Genre, len(dance.Genres)),
+		Handshakes: make([]api.Handshake, len(dance.HoldingTypes)),
+		RegionIds:  make([]int, len(dance.RegionIds)),
+	}
+	if dance.Complexity != nil {
+		complexity := int(*dance.Complexity)
+		res.Complexity = &complexity
+	}
+	for i, pace := range dance.Paces {
+		res.Paces[i] = int(pace)
+	}
+	for i, genre := range dance.Genres {
+		res.Genres[i] = api.Genre(genre)
+	}
+	for i, handshake := range dance.HoldingTypes {
+		res.Handshakes[i] = api.Handshake(handshake)
+	}
+	for i, regionID := range dance.RegionIds {
+		res.RegionIds[i] = int(regionID)
+	}
+	return res
+}
+
+func fromNames(names api.Names) domain.Translation {
+	return domain.Translation{
+		ArmName: names.Hy,
+		EngName: deref(names.En),
+		RuName:  deref(names.Ru),
+	}
+}
+
+func applyNamesPatch(name *domain.Translation, patch *api.NamesPatch) {
+	if patch == nil {
+		return
+	}
+	if patch.Hy != nil {
+		name.ArmName = *patch.Hy
+	}
+	if patch.En != nil {
+		name.EngName = *patch.En
+	}
+	if patch.Ru != nil {
+		name.RuName = *patch.Ru
+	}
+}
+
+func toNames(name domain.Translation) api.Names {
+	return api.Names{Hy: name.ArmName, En: &name.EngName, Ru: &name.RuName}
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+func toInt32s(values []int) []int32 {
+	result := make([]int32, len(values))
+	for i, v := range values {
+		result[i] = int32(v)
+	}
+	return result
+}
+
+func toInt64s(values []int) []int64 {
+	result := make([]int64, len(values))
+	for i, v := range values {
+		result[i] = int64(v)
+	}
+	return result
+}
+
+func toGenres(values []api.Genre) []domain.Genre {
+	result := make([]domain.Genre, len(values))
+	for i, v := range values {
+		result[i] = domain.Genre(v)
+	}
+	return result
+}
+
+func toHoldingTypes(values []api.Handshake) []domain.HoldingType {
+	result := make([]domain.HoldingType, len(values))
+	for i, v := range values {
+		result[i] = domain.HoldingType(v)
+	}
+	return result
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func jsonBody(t *testing.T, body any) io.Reader {
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+	return bytes.NewReader(data)
+}
+
+func TestAdminDances_Integration(t *testing.T) {
+	clearTables(t)
+	ctx := context.Background()
+
+	var regTransID int64
+	err := testDBPool.QueryRow(ctx, "INSERT INTO translations (eng_name, ru_name) VALUES ('Shirak', 'Ширак') RETURNING id").Scan(&regTransID)
+	require.NoError(t, err)
+	_, err = testDBPool.Exec(ctx, "INSERT INTO regions (id, translation_id, name) VALUES (10, $1, 'Shirak_def'), (11, $1, 'Lori_def')", regTransID)
+	require.NoError(t, err)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{}).WithAdmin(adminService.NewAdminService(testDBPool, queries, &mockStorage{}))
+
+	en, ru := "Berd", "Берд"
+	complexity := 3
+	createReq := api.AdminDanceRequest{
+		Names:      api.Names{Hy: " Բերդ ", En: &en, Ru: &ru},
+		Gender:     api.DanceGenderMULTY,
+		Complexity: &complexity,
+		Paces:      &[]int{2, 1, 2},
+		Genres:     &[]api.Genre{api.WAR},
+		Handshakes: &[]api.Handshake{api.SHOULDER},
+		RegionIds:  &[]int{10},
+	}
+
+	var danceID int
+	t.Run("Create 201", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/dances", jsonBody(t, createReq))
+		w := httptest.NewRecorder()
+
+		srv.PostAdminDances(w, req)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		var response api.AdminDanceResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		// id берутся из отдельной последовательности и не пересекаются с dances.json
+		assert.GreaterOrEqual(t, response.Id, 1000000)
+		assert.Equal(t, "Բերդ", response.Names.Hy)
+		assert.Equal(t, "Berd", *response.Names.En)
+		assert.Equal(t, []int{1, 2}, response.Paces)
+		assert.Equal(t, []int{10}, response.RegionIds)
+		assert.Equal(t, "/api/v1/admin/dances/"+strconv.Itoa(response.Id), w.Header().Get("Location"))
+		danceID = response.Id
+
+		var name string
+		var createdInAdmin bool
+		err := testDBPool.QueryRow(ctx, "SELECT name, created_in_admin FROM dances WHERE id = $1", danceID).Scan(&name, &createdInAdmin)
+		require.NoError(t, err)
+		assert.Equal(t, "Բերդ", name)
+		assert.True(t, createdInAdmin)
+	})
+
+	t.Run("Create 400 - Validation", func(t *testing.T) {
+		badComplexity := 9
+		body := api.AdminDanceRequest{
+			Names:      api.Names{Hy: " "},
+			Gender:     "UNKNOWN",
+			Complexity: &badComplexity,
+			Paces:      &[]int{4},
+			Genres:     &[]api.Genre{"DISCO"},
+			RegionIds:  &[]int{999},
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/dances", jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PostAdminDances(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		var response api.ValidationErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		fields := make([]string, len(response.Problems))
+		for i, p := range response.Problems {
+			fields[i] = p.Field
+		}
+		assert.ElementsMatch(t, []string{"names.hy", "gender", "complexity", "paces", "genres", "regionIds"}, fields)
+	})
+
+	t.Run("Patch 200 - Only Sent Fields", func(t *testing.T) {
+		newRu := "Берд (Шира́к)"
+		body := api.AdminDancePatchRequest{
+			Names:     &api.NamesPatch{Ru: &newRu},
+			RegionIds: &[]int{11},
+		}
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/admin/dances/"+strconv.Itoa(danceID), jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PatchAdminDancesId(w, req, danceID)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.AdminDanceResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, newRu, *response.Names.Ru)
+		assert.Equal(t, "Berd", *response.Names.En)
+		assert.Equal(t, 3, *response.Complexity)
+		assert.Equal(t, []int{11}, response.RegionIds)
+	})
+
+	t.Run("Put 200 - Replaces Dance", func(t *testing.T) {
+		body := api.AdminDanceRequest{
+			Names:  api.Names{Hy: "Բերդ"},
+			Gender: api.DanceGenderMALE,
+		}
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/dances/"+strconv.Itoa(danceID), jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PutAdminDancesId(w, req, danceID)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.AdminDanceResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, api.DanceGenderMALE, response.Gender)
+		assert.Nil(t, response.Complexity)
+		assert.Empty(t, *response.Names.En)
+		assert.Empty(t, response.Paces)
+		assert.Empty(t, response.RegionIds)
+	})
+
+	t.Run("Delete 204 - Soft Delete", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/dances/"+strconv.Itoa(danceID), nil)
+		w := httptest.NewRecorder()
+
+		srv.DeleteAdminDancesId(w, req, danceID)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		var deleted bool
+		err := testDBPool.QueryRow(ctx, "SELECT deleted_at IS NOT NULL FROM dances WHERE id = $1", danceID).Scan(&deleted)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("Not Found 404 - Deleted Dance", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		srv.GetAdminDancesId(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/dances/"+strconv.Itoa(danceID), nil), danceID)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		body := api.AdminDancePatchRequest{Complexity: &complexity}
+		srv.PatchAdminDancesId(w, httptest.NewRequest(http.MethodPatch, "/api/v1/admin/dances/"+strconv.Itoa(danceID), jsonBody(t, body)), danceID)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		srv.DeleteAdminDancesId(w, httptest.NewRequest(http.MethodDelete, "/api/v1/admin/dances/"+strconv.Itoa(danceID), nil), danceID)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/domain"
+)
+
+// maxAudioUploadSize — предел для MP3 из админки, с запасом на длинные записи в высоком битрейте
+const maxAudioUploadSize = 50 << 20
+
+func (s *Server) GetAdminSongsId(w http.ResponseWriter, r *http.Request, id int) {
+	song, err := s.admin.GetSong(r.Context(), int64(id))
+	if err != nil {
+		s.writeAdminError(w, err, "get song")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminSongResponse(song))
+}
+
+func (s *Server) PostAdminSongs(w http.ResponseWriter, r *http.Request) {
+	var req api.AdminSongRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	song, err := s.admin.CreateSong(r.Context(), songFromRequest(req))
+	if err != nil {
+		s.writeAdminError(w, err, "create song")
+		return
+	}
+
+	w.Header().Set("Location", "/api/v1/admin/songs/"+strconv.FormatInt(song.Id, 10))
+	s.writeJSON(w, http.StatusCreated, toAdminSongResponse(song))
+}
+
+func (s *Server) PutAdminSongsId(w http.ResponseWriter, r *http.Request, id int) {
+	var req api.AdminSongRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	song, err := s.admin.UpdateSong(r.Context(), int64(id), func(song *domain.SongShort) {
+		replaced := songFromRequest(req)
+		song.Name = replaced.Name
+		song.DanceIds = replaced.DanceIds
+		song.ArtistIds = replaced.ArtistIds
+	})
+	if err != nil {
+		s.writeAdminError(w, err, "update song")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminSongResponse(song))
+}
+
+func (s *Server) PatchAdminSongsId(w http.ResponseWriter, r *http.Request, id int) {
+	var req api.AdminSongPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	song, err := s.admin.UpdateSong(r.Context(), int64(id), func(song *domain.SongShort) {
+		applyNamesPatch(&song.Name, req.Names)
+		if req.DanceIds != nil {
+			song.DanceIds = toInt64s(*req.DanceIds)
+		}
+		if req.EnsembleIds != nil {
+			song.ArtistIds = toInt64s(*req.EnsembleIds)
+		}
+	})
+	if err != nil {
+		s.writeAdminError(w, err, "patch song")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminSongResponse(song))
+}
+
+func (s *Server) DeleteAdminSongsId(w http.ResponseWriter, r *http.Request, id int) {
+	if err := s.admin.DeleteSong(r.Context(), int64(id)); err != nil {
+		s.writeAdminError(w, err, "delete song")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) PutAdminSongsIdAudio(w http.ResponseWriter, r *http.Request, id int) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxAudioUploadSize)
+	file, header, err := r.FormFile("file")
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	song, err := s.admin.ReplaceSongAudio(r.Context(), int64(id), header.Filename, data)
+	if err != nil {
+		s.writeAdminError(w, err, "replace song audio")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminSongResponse(song))
+}
+
+func songFromRequest(req api.AdminSongRequest) domain.SongShort {
+	song := domain.SongShort{Name: fromNames(req.Names)}
+	if req.DanceIds != nil {
+		song.DanceIds = toInt64s(*req.DanceIds)
+	}
+	if req.EnsembleIds != nil {
+		song.ArtistIds = toInt64s(*req.EnsembleIds)
+	}
+	return song
+}
+
+func toAdminSongResponse(song domain.SongShort) api.AdminSongResponse {
+	res := api.AdminSongResponse{
+		Id:          int(song.Id),
+		Names:       toNames(song.Name),
+		DanceIds:    make([]int, len(song.DanceIds)),
+		EnsembleIds: make([]int, len(song.ArtistIds)),
+		MediaStatus: api.MediaStatus(song.MediaStatus),
+	}
+	for i, danceID := range song.DanceIds {
+		res.DanceIds[i] = int(danceID)
+	}
+	for i, artistID := range song.ArtistIds {
+		res.EnsembleIds[i] = int(artistID)
+	}
+	if song.Audio.DurationMs > 0 {
+		res.Audio = &api.AudioInfo{
+			DurationMs:   song.Audio.DurationMs,
+			BitrateKbps:  song.Audio.BitrateKbps,
+			SampleRateHz: song.Audio.SampleRateHz,
+		}
+		if song.Audio.Title != "" {
+			res.Audio.Title = &song.Audio.Title
+		}
+		if song.Audio.Artist != "" {
+			res.Audio.Artist = &song.Audio.Artist
+		}
+	}
+	return res
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/mocks"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// testMP3 — n кадров MPEG-1 Layer III 128 кбит/с, 44100 Гц без паддинга
+func testMP3(n int) []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+	return bytes.Repeat(frame, n)
+}
+
+func audioUploadRequest(t *testing.T, songID int, data []byte) *http.Request {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "Երգ.mp3")
+	require.NoError(t, err)
+	_, err = part.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/songs/"+strconv.Itoa(songID)+"/audio", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	return req
+}
+
+func TestAdminSongs_Integration(t *testing.T) {
+	clearTables(t)
+	ctx := context.Background()
+
+	_, err := testDBPool.Exec(ctx, "INSERT INTO dances (id, name, gender) VALUES (1, 'Berd_def', 'MULTY')")
+	require.NoError(t, err)
+	_, err = testDBPool.Exec(ctx, "INSERT INTO artists (id, name, link) VALUES (200, 'Ens_def', 'http://ens.com')")
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	storage := mocks.NewMockFileStorage(ctrl)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{}).WithAdmin(adminService.NewAdminService(testDBPool, queries, storage))
+
+	var songID int
+	t.Run("Create 201", func(t *testing.T) {
+		en := "Song"
+		body := api.AdminSongRequest{
+			Names:       api.Names{Hy: "Երգ", En: &en},
+			DanceIds:    &[]int{1},
+			EnsembleIds: &[]int{200, 200},
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/songs", jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PostAdminSongs(w, req)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		var response api.AdminSongResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.GreaterOrEqual(t, response.Id, 1000000)
+		assert.Equal(t, "Երգ", response.Names.Hy)
+		assert.Equal(t, []int{1}, response.DanceIds)
+		assert.Equal(t, []int{200}, response.EnsembleIds)
+		assert.Equal(t, api.MediaStatus("MISSING"), response.MediaStatus)
+		assert.Nil(t, response.Audio)
+		songID = response.Id
+	})
+
+	t.Run("Create 400 - Unknown Links", func(t *testing.T) {
+		body := api.AdminSongRequest{
+			Names:       api.Names{Hy: ""},
+			DanceIds:    &[]int{999},
+			EnsembleIds: &[]int{998},
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/songs", jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PostAdminSongs(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		var response api.ValidationErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, []api.FieldProblem{
+			{Field: "names.hy", Reason: "is required"},
+			{Field: "danceIds", Reason: "dance 999 not found"},
+			{Field: "ensembleIds", Reason: "ensemble 998 not found"},
+		}, response.Problems)
+	})
+
+	t.Run("Upload Audio 200", func(t *testing.T) {
+		data := testMP3(100)
+		storage.EXPECT().
+			UploadFile(gomock.Any(), "Երգ.mp3", gomock.Any(), int64(len(data)), "audio/mpeg").
+			Return("audio-1", nil)
+		w := httptest.NewRecorder()
+
+		srv.PutAdminSongsIdAudio(w, audioUploadRequest(t, songID, data), songID)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.AdminSongResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, api.MediaStatus("OK"), response.MediaStatus)
+		require.NotNil(t, response.Audio)
+		assert.Equal(t, 128, response.Audio.BitrateKbps)
+		assert.Equal(t, 44100, response.Audio.SampleRateHz)
+		assert.Positive(t, response.Audio.DurationMs)
+	})
+
+	t.Run("Replace Audio 200 - Old File Deleted", func(t *testing.T) {
+		data := testMP3(50)
+		gomock.InOrder(
+			storage.EXPECT().UploadFile(gomock.Any(), "Երգ.mp3", gomock.Any(), int64(len(data)), "audio/mpeg").Return("audio-2", nil),
+			storage.EXPECT().DeleteFile(gomock.Any(), "audio-1").Return(nil),
+		)
+		w := httptest.NewRecorder()
+
+		srv.PutAdminSongsIdAudio(w, audioUploadRequest(t, songID, data), songID)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var fileKey string
+		err := testDBPool.QueryRow(ctx, "SELECT file_key FROM songs WHERE id = $1", songID).Scan(&fileKey)
+		require.NoError(t, err)
+		assert.Equal(t, "audio-2", fileKey)
+	})
+
+	t.Run("Upload Audio 400 - Not MP3", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		srv.PutAdminSongsIdAudio(w, audioUploadRequest(t, songID, []byte("not an mp3")), songID)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		var response api.ValidationErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response.Problems, 1)
+		assert.Equal(t, "file", response.Problems[0].Field)
+	})
+
+	t.Run("Patch 200 - Only Sent Fields", func(t *testing.T) {
+		body := api.AdminSongPatchRequest{EnsembleIds: &[]int{}}
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/admin/songs/"+strconv.Itoa(songID), jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PatchAdminSongsId(w, req, songID)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.AdminSongResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Song", *response.Names.En)
+		assert.Equal(t, []int{1}, response.DanceIds)
+		assert.Empty(t, response.EnsembleIds)
+		assert.Equal(t, api.MediaStatus("OK"), response.MediaStatus)
+	})
+
+	t.Run("Delete 204 - Then 404", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		srv.DeleteAdminSongsId(w, httptest.NewRequest(http.MethodDelete, "/api/v1/admin/songs/"+strconv.Itoa(songID), nil), songID)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		w = httptest.NewRecorder()
+		srv.GetAdminSongsId(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/songs/"+strconv.Itoa(songID), nil), songID)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		srv.PutAdminSongsIdAudio(w, audioUploadRequest(t, songID, testMP3(10)), songID)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/domain"
+)
+
+func (s *Server) GetAdminVideosId(w http.ResponseWriter, r *http.Request, id int) {
+	video, err := s.admin.GetVideo(r.Context(), int64(id))
+	if err != nil {
+		s.writeAdminError(w, err, "get video")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminVideoResponse(video))
+}
+
+func (s *Server) PostAdminVideos(w http.ResponseWriter, r *http.Request) {
+	var req api.AdminVideoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	video, err := s.admin.CreateVideo(r.Context(), videoFromRequest(req))
+	if err != nil {
+		s.writeAdminError(w, err, "create video")
+		return
+	}
+
+	w.Header().Set("Location", "/api/v1/admin/videos/"+strconv.FormatInt(*video.Id, 10))
+	s.writeJSON(w, http.StatusCreated, toAdminVideoResponse(video))
+}
+
+func (s *Server) PutAdminVideosId(w http.ResponseWriter, r *http.Request, id int) {
+	var req api.AdminVideoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	video, err := s.admin.UpdateVideo(r.Context(), int64(id), func(video *domain.VideoShort) {
+		*video = videoFromRequest(req)
+	})
+	if err != nil {
+		s.writeAdminError(w, err, "update video")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminVideoResponse(video))
+}
+
+func (s *Server) PatchAdminVideosId(w http.ResponseWriter, r *http.Request, id int) {
+	var req api.AdminVideoPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	video, err := s.admin.UpdateVideo(r.Context(), int64(id), func(video *domain.VideoShort) {
+		applyNamesPatch(&video.Name, req.Names)
+		if req.Link != nil {
+			video.Link = *req.Link
+		}
+		if req.Type != nil {
+			video.Type = domain.VideoType(*req.Type)
+		}
+		if req.DanceIds != nil {
+			video.DanceIds = toInt64s(*req.DanceIds)
+		}
+	})
+	if err != nil {
+		s.writeAdminError(w, err, "patch video")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, toAdminVideoResponse(video))
+}
+
+func (s *Server) DeleteAdminVideosId(w http.ResponseWriter, r *http.Request, id int) {
+	if err := s.admin.DeleteVideo(r.Context(), int64(id)); err != nil {
+		s.writeAdminError(w, err, "delete video")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func videoFromRequest(req api.AdminVideoRequest) domain.VideoShort {
+	video := domain.VideoShort{
+		Name: fromNames(req.Names),
+		Link: req.Link,
+		Type: domain.VideoType(req.Type),
+	}
+	if req.DanceIds != nil {
+		video.DanceIds = toInt64s(*req.DanceIds)
+	}
+	return video
+}
+
+func toAdminVideoResponse(video domain.VideoShort) api.AdminVideoResponse {
+	public := toVideoResponse(*video.Id, video.NameKey, video.Link)
+	res := api.AdminVideoResponse{
+		Id:        public.Id,
+		Names:     toNames(video.Name),
+		Link:      public.Link,
+		Type:      api.VideoType(video.Type),
+		DanceIds:  make([]int, len(video.DanceIds)),
+		YoutubeId: public.YoutubeId,
+		Start:     public.Start,
+		EmbedUrl:  public.EmbedUrl,
+	}
+	for i, danceID := range video.DanceIds {
+		res.DanceIds[i] = int(danceID)
+	}
+	return res
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminVideos_Integration(t *testing.T) {
+	clearTables(t)
+	ctx := context.Background()
+
+	_, err := testDBPool.Exec(ctx, "INSERT INTO dances (id, name, gender) VALUES (1, 'Berd_def', 'MULTY'), (2, 'Shalaxo_def', 'MULTY')")
+	require.NoError(t, err)
+	_, err = testDBPool.Exec(ctx, "INSERT INTO videos (id, name, link, type) VALUES (100, 'Imported', 'https://youtu.be/AAAAAAAAAAA', 'SOURCE')")
+	require.NoError(t, err)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{}).WithAdmin(adminService.NewAdminService(testDBPool, queries, &mockStorage{}))
+
+	var videoID int
+	t.Run("Create 201 - Normalized YouTube Link", func(t *testing.T) {
+		body := api.AdminVideoRequest{
+			Names:    api.Names{Hy: "Բերդ դաս"},
+			Link:     " https://youtu.be/Wk61XUxP2Mg?t=90 ",
+			Type:     api.LESSON,
+			DanceIds: &[]int{1, 2},
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/videos", jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PostAdminVideos(w, req)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		var response api.AdminVideoResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "https://www.youtube.com/watch?v=Wk61XUxP2Mg&t=90s", response.Link)
+		assert.Equal(t, "Wk61XUxP2Mg", *response.YoutubeId)
+		assert.Equal(t, 90, *response.Start)
+		assert.Equal(t, "https://www.youtube.com/embed/Wk61XUxP2Mg?start=90", *response.EmbedUrl)
+		assert.Equal(t, []int{1, 2}, response.DanceIds)
+		videoID = response.Id
+	})
+
+	t.Run("Create 400 - Duplicate In Another Form", func(t *testing.T) {
+		body := api.AdminVideoRequest{
+			Names: api.Names{Hy: "Կրկնօրինակ"},
+			Link:  "https://www.youtube.com/shorts/AAAAAAAAAAA",
+			Type:  api.VIDEO,
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/videos", jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PostAdminVideos(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		var response api.ValidationErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, []api.FieldProblem{{Field: "link", Reason: "video 100 already has this link"}}, response.Problems)
+	})
+
+	t.Run("Create 400 - Bad Fields", func(t *testing.T) {
+		body := api.AdminVideoRequest{
+			Names:    api.Names{Hy: "Տեսանյութ"},
+			Link:     "https://www.youtube.com/watch?v=bad",
+			Type:     "CONCERT",
+			DanceIds: &[]int{999},
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/videos", jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PostAdminVideos(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		var response api.ValidationErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		fields := make([]string, len(response.Problems))
+		for i, p := range response.Problems {
+			fields[i] = p.Field
+		}
+		assert.Equal(t, []string{"type", "link", "danceIds"}, fields)
+	})
+
+	t.Run("Patch 200 - Retype And Unlink", func(t *testing.T) {
+		videoType := api.VIDEO
+		body := api.AdminVideoPatchRequest{Type: &videoType, DanceIds: &[]int{2}}
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/admin/videos/"+strconv.Itoa(videoID), jsonBody(t, body))
+		w := httptest.NewRecorder()
+
+		srv.PatchAdminVideosId(w, req, videoID)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.AdminVideoResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, api.VIDEO, response.Type)
+		assert.Equal(t, []int{2}, response.DanceIds)
+		// Ссылка не изменилась, и проверка дублей не находит само видео
+		assert.Equal(t, "https://www.youtube.com/watch?v=Wk61XUxP2Mg&t=90s", response.Link)
+	})
+
+	t.Run("Dance Response Has YouTube Fields", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		srv.GetDancesId(w, httptest.NewRequest(http.MethodGet, "/api/v1/dances/2", nil), 2, api.GetDancesIdParams{})
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response api.DanceFullResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.NotNil(t, response.PerformanceVideos)
+		require.Len(t, *response.PerformanceVideos, 1)
+		assert.Equal(t, "Wk61XUxP2Mg", *(*response.PerformanceVideos)[0].YoutubeId)
+	})
+
+	t.Run("Delete 204 - Then 404", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		srv.DeleteAdminVideosId(w, httptest.NewRequest(http.MethodDelete, "/api/v1/admin/videos/"+strconv.Itoa(videoID), nil), videoID)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		w = httptest.NewRecorder()
+		srv.GetAdminVideosId(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/videos/"+strconv.Itoa(videoID), nil), videoID)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/auth"
+)
+
+// Authorize проверяет права на операции, у которых в swagger.json есть security.
+// Генератор кладёт роли операции в контекст, поэтому новые закрытые маршруты защищаются без правок здесь.
+// Операции без security открыты всем
+func Authorize(authn auth.Authenticator) api.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			roles, protected := requiredRoles(r.Context())
+			if !protected {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := authn.Authenticate(r)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			for _, role := range roles {
+				if !principal.HasRole(role) {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+			}
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// requiredRoles собирает роли из схем bearerAuth и apiKeyAuth: в swagger.json они всегда совпадают
+func requiredRoles(ctx context.Context) ([]auth.Role, bool) {
+	var roles []auth.Role
+	protected := false
+	for _, key := range []string{api.BearerAuthScopes, api.ApiKeyAuthScopes} {
+		scopes, ok := ctx.Value(key).([]string)
+		if !ok {
+			continue
+		}
+		protected = true
+		for _, scope := range scopes {
+			roles = append(roles, auth.Role(scope))
+		}
+	}
+	return roles, protected
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorize(t *testing.T) {
+	authn, err := auth.NewAuthenticator(auth.Options{APIKeys: []auth.APIKey{
+		{Name: "editor", Role: auth.RoleEditor, Key: "editor-key"},
+		{Name: "admin", Role: auth.RoleAdmin, Key: "admin-key"},
+	}})
+	require.NoError(t, err)
+
+	// Unimplemented отвечает 501: такой ответ значит, что запрос прошёл проверку прав
+	handler := api.HandlerWithOptions(api.Unimplemented{}, api.ChiServerOptions{
+		Middlewares: []api.MiddlewareFunc{Authorize(authn)},
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		key    string
+		want   int
+	}{
+		{name: "public read", method: http.MethodGet, path: "/dances/1", want: http.StatusNotImplemented},
+		{name: "write without credentials", method: http.MethodPost, path: "/admin/dances", want: http.StatusUnauthorized},
+		{name: "write with unknown key", method: http.MethodPost, path: "/admin/dances", key: "guess", want: http.StatusUnauthorized},
+		{name: "editor writes", method: http.MethodPatch, path: "/admin/dances/1", key: "editor-key", want: http.StatusNotImplemented},
+		{name: "editor cannot delete", method: http.MethodDelete, path: "/admin/dances/1", key: "editor-key", want: http.StatusForbidden},
+		{name: "admin deletes", method: http.MethodDelete, path: "/admin/dances/1", key: "admin-key", want: http.StatusNotImplemented},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.key != "" {
+				req.Header.Set(auth.APIKeyHeader, tt.key)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+			if tt.want == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
 	"github.com/Ari-Pari/backend/internal/db/sqlc"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
@@ -33,8 +36,36 @@ func (m *mockStorage) GetFileURL(key string) (string, error) {
 func (m *mockStorage) UploadFile(ctx context.Context, originalName string, reader io.Reader, fileSize int64, contentType string) (string, error) {
 	return "", nil
 }
+func (m *mockStorage) GetDownloadURL(_ context.Context, key string) (string, error) {
+	return "http://minio/" + key + "?download", nil
+}
+
+// mockAudio — содержимое, которое mockStorage отдаёт по ключу song.mp3
+const mockAudio = "ID3-mock-audio-content"
+
+func (m *mockStorage) OpenFile(_ context.Context, key string) (*filestorage.Object, error) {
+	if key != "song.mp3" {
+		return nil, filestorage.ErrFileNotFound
+	}
+	return &filestorage.Object{
+		ReadSeekCloser: nopSeekCloser{strings.NewReader(mockAudio)},
+		Size:           int64(len(mockAudio)),
+		ContentType:    "audio/mpeg",
+		ETag:           "mock-etag",
+		LastModified:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+type nopSeekCloser struct{ io.ReadSeeker }
+
+func (nopSeekCloser) Close() error { return nil }
+
 func (m *mockStorage) DeleteFile(context.Context, string) error                { return nil }
 func (m *mockStorage) GetOriginalName(context.Context, string) (string, error) { return "", nil }
+func (m *mockStorage) ListFiles(context.Context) ([]filestorage.FileInfo, error) {
+	return nil, nil
+}
+func (m *mockStorage) Ping(context.Context) error { return nil }
 
 func TestMain(m *testing.M) {
 	ctx := context.Background()
@@ -81,7 +112,7 @@ func TestMain(m *testing.M) {
 func clearTables(t *testing.T) {
 	ctx := context.Background()
 	_, err := testDBPool.Exec(ctx, `
-		TRUNCATE TABLE dance_song, songs, dance_region, videos, dance_videos, dances, regions, translations RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE dance_song, songs, song_artist, artists, dance_region, videos, dance_videos, dances, regions, groups, translations, audit_log, dance_revisions RESTART IDENTITY CASCADE;
 	`)
 	require.NoError(t, err)
 }
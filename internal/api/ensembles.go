@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *Server) GetEnsembles(w http.ResponseWriter, r *http.Request, params api.GetEnsemblesParams) {
+	ctx := r.Context()
+
+	var argLang pgtype.Text
+	if params.Lang != nil {
+		argLang = pgtype.Text{String: *params.Lang, Valid: true}
+	}
+
+	dbEnsembles, err := s.db.ListEnsembles(ctx, db.ListEnsemblesParams{
+		Lang:           argLang,
+		IncludeDeleted: params.IncludeDeleted != nil && *params.IncludeDeleted,
+	})
+	if err != nil {
+		s.logger.Printf("db error (ensembles): %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	ensembles := make([]ensembleRow, len(dbEnsembles))
+	for i, e := range dbEnsembles {
+		ensembles[i] = ensembleRow{ID: e.ID, Name: e.Name, Link: e.Link, Deleted: e.DeletedAt.Valid}
+	}
+
+	response, err := s.buildEnsembleResponses(ctx, ensembles, argLang)
+	if err != nil {
+		s.logger.Printf("db error (ensemble relations): %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(api.EnsembleListResponse(response)); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
+
+func (s *Server) GetEnsemblesId(w http.ResponseWriter, r *http.Request, id int, params api.GetEnsemblesIdParams) {
+	ctx := r.Context()
+
+	var argLang pgtype.Text
+	if params.Lang != nil {
+		argLang = pgtype.Text{String: *params.Lang, Valid: true}
+	}
+
+	dbEnsemble, err := s.db.GetEnsembleByID(ctx, db.GetEnsembleByIDParams{
+		ID:             int64(id),
+		Lang:           argLang,
+		IncludeDeleted: params.IncludeDeleted != nil && *params.IncludeDeleted,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			s.logger.Printf("db error (ensemble): %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response, err := s.buildEnsembleResponses(ctx, []ensembleRow{{
+		ID:      dbEnsemble.ID,
+		Name:    dbEnsemble.Name,
+		Link:    dbEnsemble.Link,
+		Deleted: dbEnsemble.DeletedAt.Valid,
+	}}, argLang)
+	if err != nil {
+		s.logger.Printf("db error (ensemble relations): %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response[0]); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
+
+// ensembleRow — общие поля ансамбля из ListEnsembles и GetEnsembleByID
+type ensembleRow struct {
+	ID      int64
+	Name    string
+	Link    string
+	Deleted bool
+}
+
+func (s *Server) buildEnsembleResponses(ctx context.Context, ensembles []ensembleRow, lang pgtype.Text) ([]api.EnsembleFullResponse, error) {
+	artistIDs := make([]int64, len(ensembles))
+	for i, e := range ensembles {
+		artistIDs[i] = e.ID
+	}
+
+	dbSongs, err := s.db.GetSongsByArtistIDs(ctx, db.GetSongsByArtistIDsParams{Lang: lang, ArtistIds: artistIDs})
+	if err != nil {
+		return nil, err
+	}
+	dbDances, err := s.db.GetDancesByArtistIDs(ctx, db.GetDancesByArtistIDsParams{Lang: lang, ArtistIds: artistIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	songs := make(map[int64][]api.SongShortResponse, len(ensembles))
+	for _, song := range dbSongs {
+		songLink := ""
+		if song.FileKey != "" {
+			songLink, _ = s.storage.GetFileURL(song.FileKey)
+		}
+		songs[song.ArtistID] = append(songs[song.ArtistID], api.SongShortResponse{
+			Id:          int(song.ID),
+			Name:        song.Name,
+			Link:        songLink,
+			MediaStatus: api.MediaStatus(song.MediaStatus),
+		})
+	}
+
+	dances := make(map[int64][]api.DanceRefResponse, len(ensembles))
+	for _, d := range dbDances {
+		dances[d.ArtistID] = append(dances[d.ArtistID], api.DanceRefResponse{Id: int(d.ID), Name: d.Name})
+	}
+
+	res := make([]api.EnsembleFullResponse, len(ensembles))
+	for i, e := range ensembles {
+		res[i] = api.EnsembleFullResponse{
+			Id:      int(e.ID),
+			Name:    e.Name,
+			Link:    e.Link,
+			Deleted: e.Deleted,
+			Songs:   songs[e.ID],
+			Dances:  dances[e.ID],
+		}
+		if res[i].Songs == nil {
+			res[i].Songs = []api.SongShortResponse{}
+		}
+		if res[i].Dances == nil {
+			res[i].Dances = []api.DanceRefResponse{}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEnsembles_Integration(t *testing.T) {
+	clearTables(t)
+	seedSongs(t)
+	ctx := context.Background()
+
+	// Ансамбль с типом EXTRA скрыт по умолчанию
+	var extraTransID int64
+	err := testDBPool.QueryRow(ctx, "INSERT INTO translations (eng_name, ru_name) VALUES ('Extra', 'Экстра') RETURNING id").Scan(&extraTransID)
+	require.NoError(t, err)
+	_, err = testDBPool.Exec(ctx, "INSERT INTO artists (id, translation_id, name, link, deleted_at) VALUES (201, $1, 'Extra_def', '', NOW())", extraTransID)
+	require.NoError(t, err)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{})
+
+	t.Run("Success 200 - Hides Deleted", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/ensembles?lang=ru", nil)
+		w := httptest.NewRecorder()
+
+		lang := "ru"
+		srv.GetEnsembles(w, req, api.GetEnsemblesParams{Lang: &lang})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response api.EnsembleListResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		require.Len(t, response, 1)
+		assert.Equal(t, "Ансамбль А", response[0].Name)
+		assert.Equal(t, "http://ens.com", response[0].Link)
+		assert.False(t, response[0].Deleted)
+		require.Len(t, response[0].Songs, 2)
+		assert.Equal(t, "http://minio/song.mp3", response[0].Songs[0].Link)
+		require.Len(t, response[0].Dances, 1)
+		assert.Equal(t, "Берд", response[0].Dances[0].Name)
+	})
+
+	t.Run("Success 200 - Include Deleted", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/ensembles?includeDeleted=true", nil)
+		w := httptest.NewRecorder()
+
+		includeDeleted := true
+		srv.GetEnsembles(w, req, api.GetEnsemblesParams{IncludeDeleted: &includeDeleted})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response api.EnsembleListResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		require.Len(t, response, 2)
+		assert.True(t, response[1].Deleted)
+		assert.Empty(t, response[1].Songs)
+	})
+
+	t.Run("Not Found 404 - Deleted Without Flag", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/ensembles/201", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetEnsemblesId(w, req, 201, api.GetEnsemblesIdParams{})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Success 200 - By Id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/ensembles/200", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetEnsemblesId(w, req, 200, api.GetEnsemblesIdParams{})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response api.EnsembleFullResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Ens_def", response.Name)
+		assert.Len(t, response.Songs, 2)
+	})
+
+	t.Run("Success 200 - No Dances Of Deleted Songs", func(t *testing.T) {
+		_, err := testDBPool.Exec(ctx, "UPDATE songs SET deleted_at = NOW() WHERE id = 50")
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/ensembles/200", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetEnsemblesId(w, req, 200, api.GetEnsemblesIdParams{})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response api.EnsembleFullResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Len(t, response.Songs, 1)
+		assert.Empty(t, response.Dances)
+	})
+}
@@ -4,13 +4,93 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AuditAction.
+const (
+	Create  AuditAction = "create"
+	Delete  AuditAction = "delete"
+	Restore AuditAction = "restore"
+	Update  AuditAction = "update"
+)
+
+// Valid indicates whether the value is a known member of the AuditAction enum.
+func (e AuditAction) Valid() bool {
+	switch e {
+	case Create:
+		return true
+	case Delete:
+		return true
+	case Restore:
+		return true
+	case Update:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AuditEntityType.
+const (
+	Artist AuditEntityType = "artist"
+	Dance  AuditEntityType = "dance"
+	Group  AuditEntityType = "group"
+	Region AuditEntityType = "region"
+	Song   AuditEntityType = "song"
+	Video  AuditEntityType = "video"
+)
+
+// Valid indicates whether the value is a known member of the AuditEntityType enum.
+func (e AuditEntityType) Valid() bool {
+	switch e {
+	case Artist:
+		return true
+	case Dance:
+		return true
+	case Group:
+		return true
+	case Region:
+		return true
+	case Song:
+		return true
+	case Video:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CheckStatus.
+const (
+	Fail CheckStatus = "fail"
+	Ok   CheckStatus = "ok"
+)
+
+// Valid indicates whether the value is a known member of the CheckStatus enum.
+func (e CheckStatus) Valid() bool {
+	switch e {
+	case Fail:
+		return true
+	case Ok:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for DanceFullResponseGender.
 const (
 	DanceFullResponseGenderFemale DanceFullResponseGender = "female"
@@ -32,6 +112,27 @@ func (e DanceFullResponseGender) Valid() bool {
 	}
 }
 
+// Defines values for DanceGender.
+const (
+	DanceGenderFEMALE DanceGender = "FEMALE"
+	DanceGenderMALE   DanceGender = "MALE"
+	DanceGenderMULTY  DanceGender = "MULTY"
+)
+
+// Valid indicates whether the value is a known member of the DanceGender enum.
+func (e DanceGender) Valid() bool {
+	switch e {
+	case DanceGenderFEMALE:
+		return true
+	case DanceGenderMALE:
+		return true
+	case DanceGenderMULTY:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for DanceSearchRequestGenders.
 const (
 	DanceSearchRequestGendersFemale DanceSearchRequestGenders = "female"
@@ -94,19 +195,19 @@ func (e DanceSearchRequestSortedBy) Valid() bool {
 
 // Defines values for DanceShortResponseGender.
 const (
-	FEMALE DanceShortResponseGender = "FEMALE"
-	MALE   DanceShortResponseGender = "MALE"
-	MULTI  DanceShortResponseGender = "MULTI"
+	DanceShortResponseGenderFEMALE DanceShortResponseGender = "FEMALE"
+	DanceShortResponseGenderMALE   DanceShortResponseGender = "MALE"
+	DanceShortResponseGenderMULTI  DanceShortResponseGender = "MULTI"
 )
 
 // Valid indicates whether the value is a known member of the DanceShortResponseGender enum.
 func (e DanceShortResponseGender) Valid() bool {
 	switch e {
-	case FEMALE:
+	case DanceShortResponseGenderFEMALE:
 		return true
-	case MALE:
+	case DanceShortResponseGenderMALE:
 		return true
-	case MULTI:
+	case DanceShortResponseGenderMULTI:
 		return true
 	default:
 		return false
@@ -176,6 +277,30 @@ func (e Genre) Valid() bool {
 	}
 }
 
+// Defines values for GroupType.
+const (
+	DIASPORA     GroupType = "DIASPORA"
+	ORGANIZATION GroupType = "ORGANIZATION"
+	STATE        GroupType = "STATE"
+	YEREVAN      GroupType = "YEREVAN"
+)
+
+// Valid indicates whether the value is a known member of the GroupType enum.
+func (e GroupType) Valid() bool {
+	switch e {
+	case DIASPORA:
+		return true
+	case ORGANIZATION:
+		return true
+	case STATE:
+		return true
+	case YEREVAN:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Handshake.
 const (
 	BACK         Handshake = "BACK"
@@ -215,6 +340,236 @@ func (e Handshake) Valid() bool {
 	}
 }
 
+// Defines values for MediaStatus.
+const (
+	CORRUPT    MediaStatus = "CORRUPT"
+	MISSING    MediaStatus = "MISSING"
+	OK         MediaStatus = "OK"
+	UNREADABLE MediaStatus = "UNREADABLE"
+)
+
+// Valid indicates whether the value is a known member of the MediaStatus enum.
+func (e MediaStatus) Valid() bool {
+	switch e {
+	case CORRUPT:
+		return true
+	case MISSING:
+		return true
+	case OK:
+		return true
+	case UNREADABLE:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for VideoType.
+const (
+	LESSON VideoType = "LESSON"
+	SOURCE VideoType = "SOURCE"
+	VIDEO  VideoType = "VIDEO"
+)
+
+// Valid indicates whether the value is a known member of the VideoType enum.
+func (e VideoType) Valid() bool {
+	switch e {
+	case LESSON:
+		return true
+	case SOURCE:
+		return true
+	case VIDEO:
+		return true
+	default:
+		return false
+	}
+}
+
+// AdminDancePatchRequest defines model for AdminDancePatchRequest.
+type AdminDancePatchRequest struct {
+	Complexity *int `json:"complexity,omitempty"`
+
+	// Gender Значения domain.Gender
+	Gender     *DanceGender `json:"gender,omitempty"`
+	Genres     *[]Genre     `json:"genres,omitempty"`
+	Handshakes *[]Handshake `json:"handshakes,omitempty"`
+
+	// Names Переводы, которые нужно заменить; остальные не меняются
+	Names     *NamesPatch `json:"names,omitempty"`
+	Paces     *[]int      `json:"paces,omitempty"`
+	RegionIds *[]int      `json:"regionIds,omitempty"`
+}
+
+// AdminDanceRequest defines model for AdminDanceRequest.
+type AdminDanceRequest struct {
+	Complexity *int `json:"complexity,omitempty"`
+
+	// Gender Значения domain.Gender
+	Gender     DanceGender  `json:"gender"`
+	Genres     *[]Genre     `json:"genres,omitempty"`
+	Handshakes *[]Handshake `json:"handshakes,omitempty"`
+
+	// Names Название на трёх языках
+	Names     Names  `json:"names"`
+	Paces     *[]int `json:"paces,omitempty"`
+	RegionIds *[]int `json:"regionIds,omitempty"`
+}
+
+// AdminDanceResponse defines model for AdminDanceResponse.
+type AdminDanceResponse struct {
+	Complexity *int `json:"complexity,omitempty"`
+
+	// Gender Значения domain.Gender
+	Gender     DanceGender `json:"gender"`
+	Genres     []Genre     `json:"genres"`
+	Handshakes []Handshake `json:"handshakes"`
+	Id         int         `json:"id"`
+
+	// Names Название на трёх языках
+	Names     Names `json:"names"`
+	Paces     []int `json:"paces"`
+	RegionIds []int `json:"regionIds"`
+}
+
+// AdminSongPatchRequest defines model for AdminSongPatchRequest.
+type AdminSongPatchRequest struct {
+	// DanceIds Танцы, под которые звучит песня
+	DanceIds *[]int `json:"danceIds,omitempty"`
+
+	// EnsembleIds Ансамбли-исполнители
+	EnsembleIds *[]int `json:"ensembleIds,omitempty"`
+
+	// Names Переводы, которые нужно заменить; остальные не меняются
+	Names *NamesPatch `json:"names,omitempty"`
+}
+
+// AdminSongRequest defines model for AdminSongRequest.
+type AdminSongRequest struct {
+	// DanceIds Танцы, под которые звучит песня
+	DanceIds *[]int `json:"danceIds,omitempty"`
+
+	// EnsembleIds Ансамбли-исполнители
+	EnsembleIds *[]int `json:"ensembleIds,omitempty"`
+
+	// Names Название на трёх языках
+	Names Names `json:"names"`
+}
+
+// AdminSongResponse defines model for AdminSongResponse.
+type AdminSongResponse struct {
+	// Audio Данные из заголовков MP3 и ID3-тегов, прочитанные при загрузке файла
+	Audio       *AudioInfo `json:"audio,omitempty"`
+	DanceIds    []int      `json:"danceIds"`
+	EnsembleIds []int      `json:"ensembleIds"`
+	Id          int        `json:"id"`
+
+	// MediaStatus OK — файл загружен, MISSING — файл не найден при импорте, UNREADABLE — файл пустой или не читается, CORRUPT — файл загружен, но в нём нет аудиоданных
+	MediaStatus MediaStatus `json:"mediaStatus"`
+
+	// Names Название на трёх языках
+	Names Names `json:"names"`
+}
+
+// AdminVideoPatchRequest defines model for AdminVideoPatchRequest.
+type AdminVideoPatchRequest struct {
+	// DanceIds Танцы, к которым относится видео
+	DanceIds *[]int  `json:"danceIds,omitempty"`
+	Link     *string `json:"link,omitempty"`
+
+	// Names Переводы, которые нужно заменить; остальные не меняются
+	Names *NamesPatch `json:"names,omitempty"`
+
+	// Type SOURCE — первоисточник, LESSON — урок, VIDEO — выступление
+	Type *VideoType `json:"type,omitempty"`
+}
+
+// AdminVideoRequest defines model for AdminVideoRequest.
+type AdminVideoRequest struct {
+	// DanceIds Танцы, к которым относится видео
+	DanceIds *[]int `json:"danceIds,omitempty"`
+	Link     string `json:"link"`
+
+	// Names Название на трёх языках
+	Names Names `json:"names"`
+
+	// Type SOURCE — первоисточник, LESSON — урок, VIDEO — выступление
+	Type VideoType `json:"type"`
+}
+
+// AdminVideoResponse defines model for AdminVideoResponse.
+type AdminVideoResponse struct {
+	DanceIds []int `json:"danceIds"`
+
+	// EmbedUrl Ссылка для iframe, только для YouTube
+	EmbedUrl *string `json:"embedUrl,omitempty"`
+	Id       int     `json:"id"`
+	Link     string  `json:"link"`
+
+	// Names Название на трёх языках
+	Names Names `json:"names"`
+
+	// Start С какой секунды начинать ролик, только для YouTube
+	Start *int `json:"start,omitempty"`
+
+	// Type SOURCE — первоисточник, LESSON — урок, VIDEO — выступление
+	Type VideoType `json:"type"`
+
+	// YoutubeId id ролика, только для YouTube
+	YoutubeId *string `json:"youtubeId,omitempty"`
+}
+
+// AudioInfo Данные из заголовков MP3 и ID3-тегов, прочитанные при загрузке файла
+type AudioInfo struct {
+	// Artist Исполнитель из ID3-тега
+	Artist *string `json:"artist,omitempty"`
+
+	// BitrateKbps Битрейт в кбит/с, для VBR — средний
+	BitrateKbps int `json:"bitrateKbps"`
+
+	// DurationMs Длительность в миллисекундах
+	DurationMs int `json:"durationMs"`
+
+	// SampleRateHz Частота дискретизации в Гц
+	SampleRateHz int `json:"sampleRateHz"`
+
+	// Title Название из ID3-тега
+	Title *string `json:"title,omitempty"`
+}
+
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditChange Значение поля до и после правки, у новой записи old пустой
+type AuditChange struct {
+	New interface{} `json:"new"`
+	Old interface{} `json:"old"`
+}
+
+// AuditEntityType defines model for AuditEntityType.
+type AuditEntityType string
+
+// AuditEntryResponse defines model for AuditEntryResponse.
+type AuditEntryResponse struct {
+	Action    AuditAction `json:"action"`
+	Actor     string      `json:"actor"`
+	CreatedAt time.Time   `json:"createdAt"`
+
+	// Diff Изменившиеся поля
+	Diff       map[string]AuditChange `json:"diff"`
+	EntityId   int64                  `json:"entityId"`
+	EntityType AuditEntityType        `json:"entityType"`
+	Id         int64                  `json:"id"`
+
+	// ImportRunId Только у записей автозагрузки
+	ImportRunId *string `json:"importRunId,omitempty"`
+}
+
+// AuditLogResponse defines model for AuditLogResponse.
+type AuditLogResponse = []AuditEntryResponse
+
+// CheckStatus defines model for CheckStatus.
+type CheckStatus string
+
 // DanceFullResponse defines model for DanceFullResponse.
 type DanceFullResponse struct {
 	Complexity        int                     `json:"complexity"`
@@ -226,15 +581,47 @@ type DanceFullResponse struct {
 	Name              string                  `json:"name"`
 	Paces             []int                   `json:"paces"`
 	PerformanceVideos *[]VideoResponse        `json:"performanceVideos,omitempty"`
-	PhotoLink         string                  `json:"photo_link"`
-	Regions           []RegionResponse        `json:"regions"`
-	Songs             []SongResponse          `json:"songs"`
-	SourceVideos      *[]VideoResponse        `json:"sourceVideos,omitempty"`
+
+	// PhotoLink Исходное фото
+	PhotoLink string `json:"photo_link"`
+
+	// PhotoSources Все размеры фото в WebP и JPEG, WebP первым
+	PhotoSources *[]PhotoSource   `json:"photo_sources,omitempty"`
+	Regions      []RegionResponse `json:"regions"`
+	Songs        []SongResponse   `json:"songs"`
+	SourceVideos *[]VideoResponse `json:"sourceVideos,omitempty"`
 }
 
 // DanceFullResponseGender defines model for DanceFullResponse.Gender.
 type DanceFullResponseGender string
 
+// DanceGender Значения domain.Gender
+type DanceGender string
+
+// DanceRefResponse defines model for DanceRefResponse.
+type DanceRefResponse struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// DanceRevisionListResponse defines model for DanceRevisionListResponse.
+type DanceRevisionListResponse = []DanceRevisionResponse
+
+// DanceRevisionResponse defines model for DanceRevisionResponse.
+type DanceRevisionResponse struct {
+	// Actor Автор изменения. Пустой у версии, снятой до начала истории танца
+	Actor     *string            `json:"actor,omitempty"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Dance     AdminDanceResponse `json:"dance"`
+	Deleted   bool               `json:"deleted"`
+
+	// ImportRunId Только у версий, записанных автозагрузкой
+	ImportRunId *string `json:"importRunId,omitempty"`
+	Rev         int     `json:"rev"`
+	SongIds     []int   `json:"songIds"`
+	VideoIds    []int   `json:"videoIds"`
+}
+
 // DanceSearchRequest defines model for DanceSearchRequest.
 type DanceSearchRequest struct {
 	Complexities []int                       `json:"complexities"`
@@ -269,13 +656,39 @@ type DanceShortResponse struct {
 	Id         *int                     `json:"id,omitempty"`
 	Name       string                   `json:"name"`
 	Paces      []int                    `json:"paces"`
-	PhotoLink  string                   `json:"photo_link"`
-	Regions    []RegionResponse         `json:"regions"`
+
+	// PhotoLink Миниатюра фото в JPEG, если есть, иначе исходное фото
+	PhotoLink string `json:"photo_link"`
+
+	// PhotoSources Миниатюра фото в WebP и JPEG, WebP первым
+	PhotoSources *[]PhotoSource   `json:"photo_sources,omitempty"`
+	Regions      []RegionResponse `json:"regions"`
 }
 
 // DanceShortResponseGender defines model for DanceShortResponse.Gender.
 type DanceShortResponseGender string
 
+// DependencyCheck defines model for DependencyCheck.
+type DependencyCheck struct {
+	Error     *string     `json:"error,omitempty"`
+	LatencyMs int64       `json:"latencyMs"`
+	Name      string      `json:"name"`
+	Status    CheckStatus `json:"status"`
+}
+
+// EnsembleFullResponse defines model for EnsembleFullResponse.
+type EnsembleFullResponse struct {
+	Dances  []DanceRefResponse  `json:"dances"`
+	Deleted bool                `json:"deleted"`
+	Id      int                 `json:"id"`
+	Link    string              `json:"link"`
+	Name    string              `json:"name"`
+	Songs   []SongShortResponse `json:"songs"`
+}
+
+// EnsembleListResponse defines model for EnsembleListResponse.
+type EnsembleListResponse = []EnsembleFullResponse
+
 // EnsembleResponse defines model for EnsembleResponse.
 type EnsembleResponse struct {
 	Id   int    `json:"id"`
@@ -283,12 +696,82 @@ type EnsembleResponse struct {
 	Name string `json:"name"`
 }
 
+// FieldProblem defines model for FieldProblem.
+type FieldProblem struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
 // Genre defines model for Genre.
 type Genre string
 
+// GroupListResponse defines model for GroupListResponse.
+type GroupListResponse = []GroupResponse
+
+// GroupResponse defines model for GroupResponse.
+type GroupResponse struct {
+	CountryCode string    `json:"countryCode"`
+	Flag        string    `json:"flag"`
+	Id          int       `json:"id"`
+	Link        string    `json:"link"`
+	Name        string    `json:"name"`
+	Type        GroupType `json:"type"`
+}
+
+// GroupType defines model for GroupType.
+type GroupType string
+
 // Handshake defines model for Handshake.
 type Handshake string
 
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	Status CheckStatus `json:"status"`
+}
+
+// LyricsResponse defines model for LyricsResponse.
+type LyricsResponse struct {
+	// Html Текст с разметкой из безопасного подмножества тегов (b, i, u, s), переносы строк — \n
+	Html string `json:"html"`
+
+	// Text Текст без разметки
+	Text string `json:"text"`
+}
+
+// MediaStatus OK — файл загружен, MISSING — файл не найден при импорте, UNREADABLE — файл пустой или не читается, CORRUPT — файл загружен, но в нём нет аудиоданных
+type MediaStatus string
+
+// Names Название на трёх языках
+type Names struct {
+	En *string `json:"en,omitempty"`
+
+	// Hy Армянское название, оно же ключ названия
+	Hy string  `json:"hy"`
+	Ru *string `json:"ru,omitempty"`
+}
+
+// NamesPatch Переводы, которые нужно заменить; остальные не меняются
+type NamesPatch struct {
+	En *string `json:"en,omitempty"`
+	Hy *string `json:"hy,omitempty"`
+	Ru *string `json:"ru,omitempty"`
+}
+
+// PhotoSource Источник для <source> внутри <picture>: варианты фото одного формата
+type PhotoSource struct {
+	// Srcset Ссылки с шириной в формате srcset
+	Srcset string `json:"srcset"`
+
+	// Type MIME-тип вариантов
+	Type string `json:"type"`
+}
+
+// ReadinessResponse defines model for ReadinessResponse.
+type ReadinessResponse struct {
+	Checks []DependencyCheck `json:"checks"`
+	Status CheckStatus       `json:"status"`
+}
+
 // RegionListResponse defines model for RegionListResponse.
 type RegionListResponse = []RegionResponse
 
@@ -298,19 +781,104 @@ type RegionResponse struct {
 	Name string `json:"name"`
 }
 
+// SongFullResponse defines model for SongFullResponse.
+type SongFullResponse struct {
+	// Audio Данные из заголовков MP3 и ID3-тегов, прочитанные при загрузке файла
+	Audio     *AudioInfo         `json:"audio,omitempty"`
+	Dances    []DanceRefResponse `json:"dances"`
+	Ensembles []EnsembleResponse `json:"ensembles"`
+	Id        int                `json:"id"`
+	Link      string             `json:"link"`
+
+	// MediaStatus OK — файл загружен, MISSING — файл не найден при импорте, UNREADABLE — файл пустой или не читается, CORRUPT — файл загружен, но в нём нет аудиоданных
+	MediaStatus MediaStatus `json:"mediaStatus"`
+	Name        string      `json:"name"`
+}
+
+// SongListResponse defines model for SongListResponse.
+type SongListResponse = []SongFullResponse
+
 // SongResponse defines model for SongResponse.
 type SongResponse struct {
+	// Audio Данные из заголовков MP3 и ID3-тегов, прочитанные при загрузке файла
+	Audio     *AudioInfo         `json:"audio,omitempty"`
 	Ensembles []EnsembleResponse `json:"ensembles"`
 	Id        int                `json:"id"`
 	Link      string             `json:"link"`
-	Name      string             `json:"name"`
+	Lyrics    *LyricsResponse    `json:"lyrics,omitempty"`
+
+	// MediaStatus OK — файл загружен, MISSING — файл не найден при импорте, UNREADABLE — файл пустой или не читается, CORRUPT — файл загружен, но в нём нет аудиоданных
+	MediaStatus MediaStatus `json:"mediaStatus"`
+	Name        string      `json:"name"`
 }
 
-// VideoResponse defines model for VideoResponse.
-type VideoResponse struct {
+// SongShortResponse defines model for SongShortResponse.
+type SongShortResponse struct {
 	Id   int    `json:"id"`
 	Link string `json:"link"`
-	Name string `json:"name"`
+
+	// MediaStatus OK — файл загружен, MISSING — файл не найден при импорте, UNREADABLE — файл пустой или не читается, CORRUPT — файл загружен, но в нём нет аудиоданных
+	MediaStatus MediaStatus `json:"mediaStatus"`
+	Name        string      `json:"name"`
+}
+
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	Problems []FieldProblem `json:"problems"`
+}
+
+// VideoResponse defines model for VideoResponse.
+type VideoResponse struct {
+	// EmbedUrl Ссылка для iframe, только для YouTube
+	EmbedUrl *string `json:"embedUrl,omitempty"`
+	Id       int     `json:"id"`
+	Link     string  `json:"link"`
+	Name     string  `json:"name"`
+
+	// Start С какой секунды начинать ролик, только для YouTube
+	Start *int `json:"start,omitempty"`
+
+	// YoutubeId id ролика, только для YouTube
+	YoutubeId *string `json:"youtubeId,omitempty"`
+}
+
+// VideoType SOURCE — первоисточник, LESSON — урок, VIDEO — выступление
+type VideoType string
+
+// GetAdminAuditParams defines parameters for GetAdminAudit.
+type GetAdminAuditParams struct {
+	// EntityType Тип записи
+	EntityType *AuditEntityType `form:"entityType,omitempty" json:"entityType,omitempty"`
+
+	// EntityId Идентификатор записи
+	EntityId *int `form:"entityId,omitempty" json:"entityId,omitempty"`
+
+	// Actor Автор правки: sub из токена, api-key:<имя> или autoupload
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Действие
+	Action *AuditAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// ImportRunId Идентификатор запуска автозагрузки
+	ImportRunId *string `form:"importRunId,omitempty" json:"importRunId,omitempty"`
+
+	// From Не раньше этого времени
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Раньше этого времени
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Размер страницы, по умолчанию 50, не больше 500
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// PutAdminSongsIdAudioMultipartBody defines parameters for PutAdminSongsIdAudio.
+type PutAdminSongsIdAudioMultipartBody struct {
+	// File MP3-файл
+	File openapi_types.File `json:"file"`
 }
 
 // PostDancesSearchParams defines parameters for PostDancesSearch.
@@ -331,66 +899,1345 @@ type GetDancesIdParams struct {
 	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
 }
 
-// GetRegionsParams defines parameters for GetRegions.
-type GetRegionsParams struct {
+// GetEnsemblesParams defines parameters for GetEnsembles.
+type GetEnsemblesParams struct {
 	// Lang Язык
 	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
+
+	// IncludeDeleted Показывать скрытые ансамбли
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
 }
 
-// PostDancesSearchJSONRequestBody defines body for PostDancesSearch for application/json ContentType.
-type PostDancesSearchJSONRequestBody = DanceSearchRequest
+// GetEnsemblesIdParams defines parameters for GetEnsemblesId.
+type GetEnsemblesIdParams struct {
+	// Lang Язык
+	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Поиск танцев
-	// (POST /dances/search)
-	PostDancesSearch(w http.ResponseWriter, r *http.Request, params PostDancesSearchParams)
-	// Получить танец
-	// (GET /dances/{id})
-	GetDancesId(w http.ResponseWriter, r *http.Request, id int, params GetDancesIdParams)
-	// Получить список регионов
-	// (GET /regions)
-	GetRegions(w http.ResponseWriter, r *http.Request, params GetRegionsParams)
+	// IncludeDeleted Показывать скрытые ансамбли
+	IncludeDeleted *bool `form:"includeDeleted,omitempty" json:"includeDeleted,omitempty"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// GetGroupsParams defines parameters for GetGroups.
+type GetGroupsParams struct {
+	// Lang Язык
+	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
 
-type Unimplemented struct{}
+	// Type Тип группы
+	Type *GroupType `form:"type,omitempty" json:"type,omitempty"`
 
-// Поиск танцев
-// (POST /dances/search)
-func (_ Unimplemented) PostDancesSearch(w http.ResponseWriter, r *http.Request, params PostDancesSearchParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Country Код страны ISO 3166-1 alpha-2
+	Country *string `form:"country,omitempty" json:"country,omitempty"`
 }
 
-// Получить танец
-// (GET /dances/{id})
-func (_ Unimplemented) GetDancesId(w http.ResponseWriter, r *http.Request, id int, params GetDancesIdParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetRegionsParams defines parameters for GetRegions.
+type GetRegionsParams struct {
+	// Lang Язык
+	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
 }
 
-// Получить список регионов
-// (GET /regions)
+// GetSongsParams defines parameters for GetSongs.
+type GetSongsParams struct {
+	// Lang Язык
+	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Размер страницы
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+
+	// DanceId Идентификатор танца
+	DanceId *int `form:"danceId,omitempty" json:"danceId,omitempty"`
+
+	// EnsembleId Идентификатор ансамбля
+	EnsembleId *int `form:"ensembleId,omitempty" json:"ensembleId,omitempty"`
+
+	// SearchText Поиск по названию
+	SearchText *string `form:"searchText,omitempty" json:"searchText,omitempty"`
+}
+
+// GetSongsIdParams defines parameters for GetSongsId.
+type GetSongsIdParams struct {
+	// Lang Язык
+	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
+}
+
+// GetSongsIdAudioParams defines parameters for GetSongsIdAudio.
+type GetSongsIdAudioParams struct {
+	// Range Диапазон байт, например bytes=0-
+	Range *string `json:"Range,omitempty"`
+}
+
+// PostAdminDancesJSONRequestBody defines body for PostAdminDances for application/json ContentType.
+type PostAdminDancesJSONRequestBody = AdminDanceRequest
+
+// PatchAdminDancesIdJSONRequestBody defines body for PatchAdminDancesId for application/json ContentType.
+type PatchAdminDancesIdJSONRequestBody = AdminDancePatchRequest
+
+// PutAdminDancesIdJSONRequestBody defines body for PutAdminDancesId for application/json ContentType.
+type PutAdminDancesIdJSONRequestBody = AdminDanceRequest
+
+// PostAdminSongsJSONRequestBody defines body for PostAdminSongs for application/json ContentType.
+type PostAdminSongsJSONRequestBody = AdminSongRequest
+
+// PatchAdminSongsIdJSONRequestBody defines body for PatchAdminSongsId for application/json ContentType.
+type PatchAdminSongsIdJSONRequestBody = AdminSongPatchRequest
+
+// PutAdminSongsIdJSONRequestBody defines body for PutAdminSongsId for application/json ContentType.
+type PutAdminSongsIdJSONRequestBody = AdminSongRequest
+
+// PutAdminSongsIdAudioMultipartRequestBody defines body for PutAdminSongsIdAudio for multipart/form-data ContentType.
+type PutAdminSongsIdAudioMultipartRequestBody PutAdminSongsIdAudioMultipartBody
+
+// PostAdminVideosJSONRequestBody defines body for PostAdminVideos for application/json ContentType.
+type PostAdminVideosJSONRequestBody = AdminVideoRequest
+
+// PatchAdminVideosIdJSONRequestBody defines body for PatchAdminVideosId for application/json ContentType.
+type PatchAdminVideosIdJSONRequestBody = AdminVideoPatchRequest
+
+// PutAdminVideosIdJSONRequestBody defines body for PutAdminVideosId for application/json ContentType.
+type PutAdminVideosIdJSONRequestBody = AdminVideoRequest
+
+// PostDancesSearchJSONRequestBody defines body for PostDancesSearch for application/json ContentType.
+type PostDancesSearchJSONRequestBody = DanceSearchRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Журнал изменений каталога
+	// (GET /admin/audit)
+	GetAdminAudit(w http.ResponseWriter, r *http.Request, params GetAdminAuditParams)
+	// Создать танец
+	// (POST /admin/dances)
+	PostAdminDances(w http.ResponseWriter, r *http.Request)
+	// Удалить танец
+	// (DELETE /admin/dances/{id})
+	DeleteAdminDancesId(w http.ResponseWriter, r *http.Request, id int)
+	// Получить танец со всеми переводами
+	// (GET /admin/dances/{id})
+	GetAdminDancesId(w http.ResponseWriter, r *http.Request, id int)
+	// Изменить часть полей танца
+	// (PATCH /admin/dances/{id})
+	PatchAdminDancesId(w http.ResponseWriter, r *http.Request, id int)
+	// Заменить танец
+	// (PUT /admin/dances/{id})
+	PutAdminDancesId(w http.ResponseWriter, r *http.Request, id int)
+	// Версии танца
+	// (GET /admin/dances/{id}/revisions)
+	GetAdminDancesIdRevisions(w http.ResponseWriter, r *http.Request, id int)
+	// Вернуть танец к версии
+	// (POST /admin/dances/{id}/revisions/{rev}/restore)
+	PostAdminDancesIdRevisionsRevRestore(w http.ResponseWriter, r *http.Request, id int, rev int)
+	// Создать песню
+	// (POST /admin/songs)
+	PostAdminSongs(w http.ResponseWriter, r *http.Request)
+	// Удалить песню
+	// (DELETE /admin/songs/{id})
+	DeleteAdminSongsId(w http.ResponseWriter, r *http.Request, id int)
+	// Песня для редактирования
+	// (GET /admin/songs/{id})
+	GetAdminSongsId(w http.ResponseWriter, r *http.Request, id int)
+	// Изменить часть полей песни
+	// (PATCH /admin/songs/{id})
+	PatchAdminSongsId(w http.ResponseWriter, r *http.Request, id int)
+	// Заменить данные песни
+	// (PUT /admin/songs/{id})
+	PutAdminSongsId(w http.ResponseWriter, r *http.Request, id int)
+	// Загрузить аудио песни
+	// (PUT /admin/songs/{id}/audio)
+	PutAdminSongsIdAudio(w http.ResponseWriter, r *http.Request, id int)
+	// Создать видео
+	// (POST /admin/videos)
+	PostAdminVideos(w http.ResponseWriter, r *http.Request)
+	// Удалить видео
+	// (DELETE /admin/videos/{id})
+	DeleteAdminVideosId(w http.ResponseWriter, r *http.Request, id int)
+	// Видео для редактирования
+	// (GET /admin/videos/{id})
+	GetAdminVideosId(w http.ResponseWriter, r *http.Request, id int)
+	// Изменить часть полей видео
+	// (PATCH /admin/videos/{id})
+	PatchAdminVideosId(w http.ResponseWriter, r *http.Request, id int)
+	// Заменить данные видео
+	// (PUT /admin/videos/{id})
+	PutAdminVideosId(w http.ResponseWriter, r *http.Request, id int)
+	// Поиск танцев
+	// (POST /dances/search)
+	PostDancesSearch(w http.ResponseWriter, r *http.Request, params PostDancesSearchParams)
+	// Получить танец
+	// (GET /dances/{id})
+	GetDancesId(w http.ResponseWriter, r *http.Request, id int, params GetDancesIdParams)
+	// Получить список ансамблей
+	// (GET /ensembles)
+	GetEnsembles(w http.ResponseWriter, r *http.Request, params GetEnsemblesParams)
+	// Получить ансамбль
+	// (GET /ensembles/{id})
+	GetEnsemblesId(w http.ResponseWriter, r *http.Request, id int, params GetEnsemblesIdParams)
+	// Получить список групп и коллективов
+	// (GET /groups)
+	GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams)
+	// Проверка работоспособности сервиса
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Проверка готовности зависимостей (PostgreSQL, MinIO)
+	// (GET /ready)
+	GetReady(w http.ResponseWriter, r *http.Request)
+	// Получить список регионов
+	// (GET /regions)
+	GetRegions(w http.ResponseWriter, r *http.Request, params GetRegionsParams)
+	// Получить список песен
+	// (GET /songs)
+	GetSongs(w http.ResponseWriter, r *http.Request, params GetSongsParams)
+	// Получить песню
+	// (GET /songs/{id})
+	GetSongsId(w http.ResponseWriter, r *http.Request, id int, params GetSongsIdParams)
+	// Слушать аудио песни
+	// (GET /songs/{id}/audio)
+	GetSongsIdAudio(w http.ResponseWriter, r *http.Request, id int, params GetSongsIdAudioParams)
+	// Скачать аудио песни под исходным именем
+	// (GET /songs/{id}/download)
+	GetSongsIdDownload(w http.ResponseWriter, r *http.Request, id int)
+	// Получить текст песни
+	// (GET /songs/{id}/lyrics)
+	GetSongsIdLyrics(w http.ResponseWriter, r *http.Request, id int)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Журнал изменений каталога
+// (GET /admin/audit)
+func (_ Unimplemented) GetAdminAudit(w http.ResponseWriter, r *http.Request, params GetAdminAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать танец
+// (POST /admin/dances)
+func (_ Unimplemented) PostAdminDances(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить танец
+// (DELETE /admin/dances/{id})
+func (_ Unimplemented) DeleteAdminDancesId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить танец со всеми переводами
+// (GET /admin/dances/{id})
+func (_ Unimplemented) GetAdminDancesId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить часть полей танца
+// (PATCH /admin/dances/{id})
+func (_ Unimplemented) PatchAdminDancesId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить танец
+// (PUT /admin/dances/{id})
+func (_ Unimplemented) PutAdminDancesId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Версии танца
+// (GET /admin/dances/{id}/revisions)
+func (_ Unimplemented) GetAdminDancesIdRevisions(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вернуть танец к версии
+// (POST /admin/dances/{id}/revisions/{rev}/restore)
+func (_ Unimplemented) PostAdminDancesIdRevisionsRevRestore(w http.ResponseWriter, r *http.Request, id int, rev int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать песню
+// (POST /admin/songs)
+func (_ Unimplemented) PostAdminSongs(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить песню
+// (DELETE /admin/songs/{id})
+func (_ Unimplemented) DeleteAdminSongsId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Песня для редактирования
+// (GET /admin/songs/{id})
+func (_ Unimplemented) GetAdminSongsId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить часть полей песни
+// (PATCH /admin/songs/{id})
+func (_ Unimplemented) PatchAdminSongsId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить данные песни
+// (PUT /admin/songs/{id})
+func (_ Unimplemented) PutAdminSongsId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить аудио песни
+// (PUT /admin/songs/{id}/audio)
+func (_ Unimplemented) PutAdminSongsIdAudio(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать видео
+// (POST /admin/videos)
+func (_ Unimplemented) PostAdminVideos(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить видео
+// (DELETE /admin/videos/{id})
+func (_ Unimplemented) DeleteAdminVideosId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Видео для редактирования
+// (GET /admin/videos/{id})
+func (_ Unimplemented) GetAdminVideosId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить часть полей видео
+// (PATCH /admin/videos/{id})
+func (_ Unimplemented) PatchAdminVideosId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить данные видео
+// (PUT /admin/videos/{id})
+func (_ Unimplemented) PutAdminVideosId(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поиск танцев
+// (POST /dances/search)
+func (_ Unimplemented) PostDancesSearch(w http.ResponseWriter, r *http.Request, params PostDancesSearchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить танец
+// (GET /dances/{id})
+func (_ Unimplemented) GetDancesId(w http.ResponseWriter, r *http.Request, id int, params GetDancesIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список ансамблей
+// (GET /ensembles)
+func (_ Unimplemented) GetEnsembles(w http.ResponseWriter, r *http.Request, params GetEnsemblesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить ансамбль
+// (GET /ensembles/{id})
+func (_ Unimplemented) GetEnsemblesId(w http.ResponseWriter, r *http.Request, id int, params GetEnsemblesIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список групп и коллективов
+// (GET /groups)
+func (_ Unimplemented) GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Проверка работоспособности сервиса
+// (GET /health)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Проверка готовности зависимостей (PostgreSQL, MinIO)
+// (GET /ready)
+func (_ Unimplemented) GetReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список регионов
+// (GET /regions)
 func (_ Unimplemented) GetRegions(w http.ResponseWriter, r *http.Request, params GetRegionsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Получить список песен
+// (GET /songs)
+func (_ Unimplemented) GetSongs(w http.ResponseWriter, r *http.Request, params GetSongsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить песню
+// (GET /songs/{id})
+func (_ Unimplemented) GetSongsId(w http.ResponseWriter, r *http.Request, id int, params GetSongsIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Слушать аудио песни
+// (GET /songs/{id}/audio)
+func (_ Unimplemented) GetSongsIdAudio(w http.ResponseWriter, r *http.Request, id int, params GetSongsIdAudioParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Скачать аудио песни под исходным именем
+// (GET /songs/{id}/download)
+func (_ Unimplemented) GetSongsIdDownload(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить текст песни
+// (GET /songs/{id}/lyrics)
+func (_ Unimplemented) GetSongsIdLyrics(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAdminAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAuditParams
+
+	// ------------- Optional query parameter "entityType" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "entityType", r.URL.Query(), &params.EntityType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entityId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "entityId", r.URL.Query(), &params.EntityId, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "actor", r.URL.Query(), &params.Actor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "action", r.URL.Query(), &params.Action, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "importRunId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "importRunId", r.URL.Query(), &params.ImportRunId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "importRunId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", r.URL.Query(), &params.Size, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminDances operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminDances(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminDances(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminDancesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminDancesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminDancesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminDancesId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminDancesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminDancesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchAdminDancesId operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminDancesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchAdminDancesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminDancesId operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminDancesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminDancesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminDancesIdRevisions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminDancesIdRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminDancesIdRevisions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminDancesIdRevisionsRevRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminDancesIdRevisionsRevRestore(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rev" -------------
+	var rev int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rev", chi.URLParam(r, "rev"), &rev, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rev", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminDancesIdRevisionsRevRestore(w, r, id, rev)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminSongs operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminSongs(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminSongs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminSongsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminSongsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminSongsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminSongsId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSongsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminSongsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchAdminSongsId operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminSongsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchAdminSongsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminSongsId operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminSongsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminSongsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminSongsIdAudio operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminSongsIdAudio(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminSongsIdAudio(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminVideos operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminVideos(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminVideos(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAdminVideosId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminVideosId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminVideosId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminVideosId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminVideosId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminVideosId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchAdminVideosId operation middleware
+func (siw *ServerInterfaceWrapper) PatchAdminVideosId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchAdminVideosId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAdminVideosId operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminVideosId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"editor"})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"editor"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAdminVideosId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostDancesSearch operation middleware
+func (siw *ServerInterfaceWrapper) PostDancesSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostDancesSearchParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lang", r.URL.Query(), &params.Lang, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lang", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", r.URL.Query(), &params.Size, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostDancesSearch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDancesId operation middleware
+func (siw *ServerInterfaceWrapper) GetDancesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDancesIdParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lang", r.URL.Query(), &params.Lang, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lang", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDancesId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEnsembles operation middleware
+func (siw *ServerInterfaceWrapper) GetEnsembles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnsemblesParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lang", r.URL.Query(), &params.Lang, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lang", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeDeleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "includeDeleted", r.URL.Query(), &params.IncludeDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeDeleted", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnsembles(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEnsemblesId operation middleware
+func (siw *ServerInterfaceWrapper) GetEnsemblesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnsemblesIdParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lang", r.URL.Query(), &params.Lang, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lang", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeDeleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "includeDeleted", r.URL.Query(), &params.IncludeDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeDeleted", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnsemblesId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupsParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lang", r.URL.Query(), &params.Lang, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lang", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "type", r.URL.Query(), &params.Type, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "country" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "country", r.URL.Query(), &params.Country, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "country", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGroups(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
-// PostDancesSearch operation middleware
-func (siw *ServerInterfaceWrapper) PostDancesSearch(w http.ResponseWriter, r *http.Request) {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReady operation middleware
+func (siw *ServerInterfaceWrapper) GetReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegions operation middleware
+func (siw *ServerInterfaceWrapper) GetRegions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostDancesSearchParams
+	var params GetRegionsParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lang", r.URL.Query(), &params.Lang, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lang", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSongs operation middleware
+func (siw *ServerInterfaceWrapper) GetSongs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSongsParams
 
 	// ------------- Optional query parameter "lang" -------------
 
@@ -416,8 +2263,32 @@ func (siw *ServerInterfaceWrapper) PostDancesSearch(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "danceId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "danceId", r.URL.Query(), &params.DanceId, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "danceId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ensembleId" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "ensembleId", r.URL.Query(), &params.EnsembleId, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ensembleId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "searchText" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "searchText", r.URL.Query(), &params.SearchText, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "searchText", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostDancesSearch(w, r, params)
+		siw.Handler.GetSongs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -427,8 +2298,8 @@ func (siw *ServerInterfaceWrapper) PostDancesSearch(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetDancesId operation middleware
-func (siw *ServerInterfaceWrapper) GetDancesId(w http.ResponseWriter, r *http.Request) {
+// GetSongsId operation middleware
+func (siw *ServerInterfaceWrapper) GetSongsId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -442,7 +2313,7 @@ func (siw *ServerInterfaceWrapper) GetDancesId(w http.ResponseWriter, r *http.Re
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDancesIdParams
+	var params GetSongsIdParams
 
 	// ------------- Optional query parameter "lang" -------------
 
@@ -453,7 +2324,7 @@ func (siw *ServerInterfaceWrapper) GetDancesId(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDancesId(w, r, id, params)
+		siw.Handler.GetSongsId(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -463,24 +2334,96 @@ func (siw *ServerInterfaceWrapper) GetDancesId(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetRegions operation middleware
-func (siw *ServerInterfaceWrapper) GetRegions(w http.ResponseWriter, r *http.Request) {
+// GetSongsIdAudio operation middleware
+func (siw *ServerInterfaceWrapper) GetSongsIdAudio(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRegionsParams
+	var params GetSongsIdAudioParams
 
-	// ------------- Optional query parameter "lang" -------------
+	headers := r.Header
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "lang", r.URL.Query(), &params.Lang, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Range", valueList[0], &Range, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSongsIdAudio(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSongsIdDownload operation middleware
+func (siw *ServerInterfaceWrapper) GetSongsIdDownload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lang", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRegions(w, r, params)
+		siw.Handler.GetSongsIdDownload(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSongsIdLyrics operation middleware
+func (siw *ServerInterfaceWrapper) GetSongsIdLyrics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSongsIdLyrics(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -603,15 +2546,102 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/audit", wrapper.GetAdminAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/dances", wrapper.PostAdminDances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/dances/{id}", wrapper.DeleteAdminDancesId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/dances/{id}", wrapper.GetAdminDancesId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/admin/dances/{id}", wrapper.PatchAdminDancesId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/dances/{id}", wrapper.PutAdminDancesId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/dances/{id}/revisions", wrapper.GetAdminDancesIdRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/dances/{id}/revisions/{rev}/restore", wrapper.PostAdminDancesIdRevisionsRevRestore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/songs", wrapper.PostAdminSongs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/songs/{id}", wrapper.DeleteAdminSongsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/songs/{id}", wrapper.GetAdminSongsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/admin/songs/{id}", wrapper.PatchAdminSongsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/songs/{id}", wrapper.PutAdminSongsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/songs/{id}/audio", wrapper.PutAdminSongsIdAudio)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/videos", wrapper.PostAdminVideos)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/videos/{id}", wrapper.DeleteAdminVideosId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/videos/{id}", wrapper.GetAdminVideosId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/admin/videos/{id}", wrapper.PatchAdminVideosId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/videos/{id}", wrapper.PutAdminVideosId)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/dances/search", wrapper.PostDancesSearch)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/dances/{id}", wrapper.GetDancesId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ensembles", wrapper.GetEnsembles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ensembles/{id}", wrapper.GetEnsemblesId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/groups", wrapper.GetGroups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ready", wrapper.GetReady)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/regions", wrapper.GetRegions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/songs", wrapper.GetSongs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/songs/{id}", wrapper.GetSongsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/songs/{id}/audio", wrapper.GetSongsIdAudio)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/songs/{id}/download", wrapper.GetSongsIdDownload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/songs/{id}/lyrics", wrapper.GetSongsIdLyrics)
+	})
 
 	return r
 }
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *Server) GetGroups(w http.ResponseWriter, r *http.Request, params api.GetGroupsParams) {
+	ctx := r.Context()
+
+	dbParams := db.ListGroupsParams{}
+	if params.Lang != nil {
+		dbParams.Lang = pgtype.Text{String: *params.Lang, Valid: true}
+	}
+	if params.Type != nil {
+		if !params.Type.Valid() {
+			http.Error(w, "unknown group type", http.StatusBadRequest)
+			return
+		}
+		dbParams.Type = pgtype.Text{String: string(*params.Type), Valid: true}
+	}
+	if params.Country != nil {
+		dbParams.CountryCode = pgtype.Text{String: *params.Country, Valid: true}
+	}
+
+	dbGroups, err := s.db.ListGroups(ctx, dbParams)
+	if err != nil {
+		s.logger.Printf("db error (groups): %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response := make(api.GroupListResponse, len(dbGroups))
+	for i, g := range dbGroups {
+		response[i] = api.GroupResponse{
+			Id:          int(g.ID),
+			Name:        g.Name,
+			Flag:        g.Flag,
+			CountryCode: g.CountryCode,
+			Link:        g.Link,
+			Type:        api.GroupType(g.Type),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGroups_Integration(t *testing.T) {
+	clearTables(t)
+	ctx := context.Background()
+
+	var ararTransID, yerevanTransID int64
+	err := testDBPool.QueryRow(ctx, "INSERT INTO translations (eng_name, ru_name) VALUES ('Ararat', 'Арарат') RETURNING id").Scan(&ararTransID)
+	require.NoError(t, err)
+	err = testDBPool.QueryRow(ctx, "INSERT INTO translations (eng_name, ru_name) VALUES ('Karin', 'Карин') RETURNING id").Scan(&yerevanTransID)
+	require.NoError(t, err)
+
+	_, err = testDBPool.Exec(ctx, `
+		INSERT INTO groups (id, translation_id, name, flag, country_code, link, type)
+		VALUES (1, $1, 'Ararat_def', '🇦🇷', 'AR', 'https://ararat.com', 'DIASPORA'),
+		       (2, $2, 'Karin_def', '🇦🇲', 'AM', 'https://karin.am', 'YEREVAN')
+	`, ararTransID, yerevanTransID)
+	require.NoError(t, err)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{})
+
+	list := func(t *testing.T, params api.GetGroupsParams) api.GroupListResponse {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+		w := httptest.NewRecorder()
+		srv.GetGroups(w, req, params)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response api.GroupListResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("Success 200 - All Groups", func(t *testing.T) {
+		lang := "ru"
+		response := list(t, api.GetGroupsParams{Lang: &lang})
+
+		require.Len(t, response, 2)
+		assert.Equal(t, "Арарат", response[0].Name)
+		assert.Equal(t, "AR", response[0].CountryCode)
+		assert.Equal(t, api.DIASPORA, response[0].Type)
+	})
+
+	t.Run("Success 200 - Filter By Type", func(t *testing.T) {
+		groupType := api.YEREVAN
+		response := list(t, api.GetGroupsParams{Type: &groupType})
+
+		require.Len(t, response, 1)
+		assert.Equal(t, "Karin_def", response[0].Name)
+	})
+
+	t.Run("Success 200 - Filter By Country", func(t *testing.T) {
+		country := "ar"
+		response := list(t, api.GetGroupsParams{Country: &country})
+
+		require.Len(t, response, 1)
+		assert.Equal(t, 1, response[0].Id)
+	})
+
+	t.Run("Bad Request 400 - Unknown Type", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/groups?type=CLUB", nil)
+		w := httptest.NewRecorder()
+
+		groupType := api.GroupType("CLUB")
+		srv.GetGroups(w, req, api.GetGroupsParams{Type: &groupType})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+)
+
+const readinessCheckTimeout = 2 * time.Second
+
+// HealthCheck — проверка одной внешней зависимости для /ready
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(api.HealthResponse{Status: api.Ok}); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
+
+func (s *Server) GetReady(w http.ResponseWriter, r *http.Request) {
+	res := api.ReadinessResponse{
+		Status: api.Ok,
+		Checks: make([]api.DependencyCheck, len(s.checks)),
+	}
+
+	for i, hc := range s.checks {
+		res.Checks[i] = runHealthCheck(r.Context(), hc)
+		if res.Checks[i].Status != api.Ok {
+			res.Status = api.Fail
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if res.Status != api.Ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
+
+func runHealthCheck(ctx context.Context, hc HealthCheck) api.DependencyCheck {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := hc.Check(ctx)
+	res := api.DependencyCheck{
+		Name:      hc.Name,
+		Status:    api.Ok,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		msg := err.Error()
+		res.Status = api.Fail
+		res.Error = &msg
+	}
+	return res
+}
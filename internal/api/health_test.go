@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHealth(t *testing.T) {
+	srv := NewServer(log.New(io.Discard, "", 0), nil, &mockStorage{})
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	w := httptest.NewRecorder()
+	srv.GetHealth(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response api.HealthResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, api.Ok, response.Status)
+}
+
+func TestGetReady(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	okCheck := HealthCheck{Name: "postgres", Check: func(ctx context.Context) error { return nil }}
+	failCheck := HealthCheck{Name: "minio", Check: func(ctx context.Context) error { return errors.New("bucket is gone") }}
+
+	t.Run("Success 200 - All Dependencies Up", func(t *testing.T) {
+		srv := NewServer(logger, nil, &mockStorage{}, okCheck)
+
+		req := httptest.NewRequest(http.MethodGet, "/ready", nil)
+		w := httptest.NewRecorder()
+		srv.GetReady(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response api.ReadinessResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, api.Ok, response.Status)
+		require.Len(t, response.Checks, 1)
+		assert.Equal(t, "postgres", response.Checks[0].Name)
+		assert.Nil(t, response.Checks[0].Error)
+	})
+
+	t.Run("Unavailable 503 - Dependency Down", func(t *testing.T) {
+		srv := NewServer(logger, nil, &mockStorage{}, okCheck, failCheck)
+
+		req := httptest.NewRequest(http.MethodGet, "/ready", nil)
+		w := httptest.NewRecorder()
+		srv.GetReady(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+		var response api.ReadinessResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, api.Fail, response.Status)
+		require.Len(t, response.Checks, 2)
+		assert.Equal(t, api.Ok, response.Checks[0].Status)
+		assert.Equal(t, api.Fail, response.Checks[1].Status)
+		require.NotNil(t, response.Checks[1].Error)
+		assert.Equal(t, "bucket is gone", *response.Checks[1].Error)
+	})
+}
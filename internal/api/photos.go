@@ -0,0 +1,66 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/domain"
+)
+
+const thumbSize = "thumb"
+
+// photoFormats — порядок <source> в <picture>: браузер берёт первый поддерживаемый формат
+var photoFormats = []string{"webp", "jpeg"}
+
+// decodePhotoVariants разбирает колонку photo_variants; испорченное значение считаем отсутствием вариантов
+func decodePhotoVariants(data []byte) []domain.PhotoVariant {
+	if len(data) == 0 {
+		return nil
+	}
+	var variants []domain.PhotoVariant
+	if err := json.Unmarshal(data, &variants); err != nil {
+		return nil
+	}
+	return variants
+}
+
+// thumbURL возвращает ссылку на JPEG-миниатюру или пустую строку, если миниатюры нет
+func (s *Server) thumbURL(variants []domain.PhotoVariant) string {
+	for _, v := range variants {
+		if v.Size == thumbSize && v.Format == "jpeg" {
+			link, err := s.storage.GetFileURL(v.Key)
+			if err == nil {
+				return link
+			}
+		}
+	}
+	return ""
+}
+
+// photoSources собирает srcset по форматам из вариантов нужных размеров; без размеров берутся все.
+// Варианты одной ширины (фото меньше размера не растягивается) попадают в srcset один раз
+func (s *Server) photoSources(variants []domain.PhotoVariant, sizes ...string) []api.PhotoSource {
+	var sources []api.PhotoSource
+	for _, format := range photoFormats {
+		var entries []string
+		seenWidths := make(map[int]bool)
+		for _, v := range variants {
+			if v.Format != format || seenWidths[v.Width] || (len(sizes) > 0 && !slices.Contains(sizes, v.Size)) {
+				continue
+			}
+			link, err := s.storage.GetFileURL(v.Key)
+			if err != nil {
+				continue
+			}
+			seenWidths[v.Width] = true
+			entries = append(entries, fmt.Sprintf("%s %dw", link, v.Width))
+		}
+		if len(entries) > 0 {
+			sources = append(sources, api.PhotoSource{Type: "image/" + format, Srcset: strings.Join(entries, ", ")})
+		}
+	}
+	return sources
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhotoSources(t *testing.T) {
+	srv := NewServer(log.New(io.Discard, "", 0), nil, &mockStorage{})
+
+	variants := decodePhotoVariants([]byte(`[
+		{"size": "thumb", "format": "jpeg", "width": 320, "height": 240, "key": "t.jpeg"},
+		{"size": "thumb", "format": "webp", "width": 320, "height": 240, "key": "t.webp"},
+		{"size": "card", "format": "jpeg", "width": 600, "height": 450, "key": "c.jpeg"},
+		{"size": "full", "format": "jpeg", "width": 600, "height": 450, "key": "f.jpeg"}
+	]`))
+
+	assert.Equal(t, "http://minio/t.jpeg", srv.thumbURL(variants))
+	assert.Equal(t, []api.PhotoSource{
+		{Type: "image/webp", Srcset: "http://minio/t.webp 320w"},
+		{Type: "image/jpeg", Srcset: "http://minio/t.jpeg 320w, http://minio/c.jpeg 600w"},
+	}, srv.photoSources(variants))
+	assert.Equal(t, []api.PhotoSource{
+		{Type: "image/webp", Srcset: "http://minio/t.webp 320w"},
+		{Type: "image/jpeg", Srcset: "http://minio/t.jpeg 320w"},
+	}, srv.photoSources(variants, thumbSize))
+
+	assert.Empty(t, srv.thumbURL(decodePhotoVariants([]byte(`[]`))))
+	assert.Nil(t, decodePhotoVariants([]byte(`not json`)))
+	assert.Empty(t, srv.photoSources([]domain.PhotoVariant{}))
+}
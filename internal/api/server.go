@@ -11,6 +11,7 @@ import (
 	"github.com/Ari-Pari/backend/internal/clients/filestorage"
 	db "github.com/Ari-Pari/backend/internal/db/sqlc"
 	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -19,6 +20,8 @@ type Server struct {
 	logger  *log.Logger
 	db      db.Querier              // бд
 	storage filestorage.FileStorage // minio
+	checks  []HealthCheck           // зависимости для /ready
+	admin   adminService.AdminService
 	// Добавьте ваши зависимости (БД, кэш, сервисы и т.д.)
 }
 
@@ -153,24 +156,31 @@ func (s *Server) PostDancesSearch(w http.ResponseWriter, r *http.Request, params
 			}
 		}
 
-		photoURL := ""
-		if d.PhotoLink.Valid && d.PhotoLink.String != "" {
+		// В списке достаточно миниатюры, исходное фото отдаём, только если вариантов нет
+		variants := decodePhotoVariants(d.PhotoVariants)
+		photoURL := s.thumbURL(variants)
+		if photoURL == "" && d.PhotoLink.Valid && d.PhotoLink.String != "" {
 			url, err := s.storage.GetFileURL(d.PhotoLink.String)
 			if err == nil {
 				photoURL = url
 			}
 		}
+		var photoSources *[]api.PhotoSource
+		if sources := s.photoSources(variants, thumbSize); len(sources) > 0 {
+			photoSources = &sources
+		}
 
 		resp = append(resp, api.DanceShortResponse{
-			Id:         &id,
-			Name:       d.Name,
-			Complexity: int(d.Complexity.Int32),
-			Gender:     genderEnum,
-			Genres:     genres,
-			Handshakes: handshakes,
-			Paces:      paces,
-			PhotoLink:  photoURL,
-			Regions:    regions,
+			Id:           &id,
+			Name:         d.Name,
+			Complexity:   int(d.Complexity.Int32),
+			Gender:       genderEnum,
+			Genres:       genres,
+			Handshakes:   handshakes,
+			Paces:        paces,
+			PhotoLink:    photoURL,
+			PhotoSources: photoSources,
+			Regions:      regions,
 		})
 	}
 
@@ -243,6 +253,9 @@ func (s *Server) GetDancesId(w http.ResponseWriter, r *http.Request, id int, par
 	if dbDance.PhotoKey.Valid && dbDance.PhotoKey.String != "" {
 		res.PhotoLink, _ = s.storage.GetFileURL(dbDance.PhotoKey.String)
 	}
+	if sources := s.photoSources(decodePhotoVariants(dbDance.PhotoVariants)); len(sources) > 0 {
+		res.PhotoSources = &sources
+	}
 
 	res.Paces = make([]int, len(dbDance.Paces))
 	for i, p := range dbDance.Paces {
@@ -279,20 +292,21 @@ func (s *Server) GetDancesId(w http.ResponseWriter, r *http.Request, id int, par
 		}
 
 		res.Songs[i] = api.SongResponse{
-			Id:        int(song.ID),
-			Name:      song.Name,
-			Link:      songLink,
-			Ensembles: ensembles,
+			Id:          int(song.ID),
+			Name:        song.Name,
+			Link:        songLink,
+			MediaStatus: api.MediaStatus(song.MediaStatus),
+			Audio:       audioInfo(song.DurationMs, song.BitrateKbps, song.SampleRateHz, song.TagTitle, song.TagArtist),
+			Ensembles:   ensembles,
+		}
+		if song.LyricsText != "" {
+			res.Songs[i].Lyrics = &api.LyricsResponse{Html: song.LyricsHtml, Text: song.LyricsText}
 		}
 	}
 
 	src, les, perf := []api.VideoResponse{}, []api.VideoResponse{}, []api.VideoResponse{}
 	for _, v := range dbVideos {
-		vid := api.VideoResponse{
-			Id:   int(v.ID),
-			Name: v.Name,
-			Link: v.Link,
-		}
+		vid := toVideoResponse(v.ID, v.Name, v.Link)
 
 		switch domain.VideoType(strings.ToUpper(v.Type)) {
 		case domain.Source:
@@ -352,10 +366,17 @@ func (s *Server) GetRegions(w http.ResponseWriter, r *http.Request, params api.G
 	}
 }
 
-func NewServer(logger *log.Logger, db db.Querier, storage filestorage.FileStorage) *Server {
+func NewServer(logger *log.Logger, db db.Querier, storage filestorage.FileStorage, checks ...HealthCheck) *Server {
 	return &Server{
 		logger:  logger,
 		db:      db,
 		storage: storage,
+		checks:  checks,
 	}
 }
+
+// WithAdmin подключает сервис для /admin. Без него сервер только читает данные
+func (s *Server) WithAdmin(admin adminService.AdminService) *Server {
+	s.admin = admin
+	return s
+}
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *Server) GetSongs(w http.ResponseWriter, r *http.Request, params api.GetSongsParams) {
+	ctx := r.Context()
+
+	page := 1
+	size := 20
+	if params.Page != nil && *params.Page > 0 {
+		page = *params.Page
+	}
+	if params.Size != nil && *params.Size > 0 {
+		size = *params.Size
+	}
+
+	var argLang pgtype.Text
+	if params.Lang != nil {
+		argLang = pgtype.Text{String: *params.Lang, Valid: true}
+	}
+
+	dbParams := db.ListSongsParams{
+		Lang:   argLang,
+		Limit:  int32(size),
+		Offset: int32((page - 1) * size),
+	}
+	if params.DanceId != nil {
+		dbParams.DanceID = pgtype.Int8{Int64: int64(*params.DanceId), Valid: true}
+	}
+	if params.EnsembleId != nil {
+		dbParams.ArtistID = pgtype.Int8{Int64: int64(*params.EnsembleId), Valid: true}
+	}
+	if params.SearchText != nil {
+		dbParams.SearchText = *params.SearchText
+	}
+
+	dbSongs, err := s.db.ListSongs(ctx, dbParams)
+	if err != nil {
+		s.logger.Printf("db error (songs): %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	songs := make([]songRow, len(dbSongs))
+	for i, song := range dbSongs {
+		songs[i] = songRow{
+			ID:          song.ID,
+			Name:        song.Name,
+			FileKey:     song.FileKey,
+			MediaStatus: song.MediaStatus,
+			Audio:       audioInfo(song.DurationMs, song.BitrateKbps, song.SampleRateHz, song.TagTitle, song.TagArtist),
+		}
+	}
+
+	response, err := s.buildSongResponses(ctx, songs, argLang)
+	if err != nil {
+		s.logger.Printf("db error (song relations): %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(api.SongListResponse(response)); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
+
+func (s *Server) GetSongsId(w http.ResponseWriter, r *http.Request, id int, params api.GetSongsIdParams) {
+	ctx := r.Context()
+
+	var argLang pgtype.Text
+	if params.Lang != nil {
+		argLang = pgtype.Text{String: *params.Lang, Valid: true}
+	}
+
+	dbSong, err := s.db.GetSongByID(ctx, db.GetSongByIDParams{ID: int64(id), Lang: argLang})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			s.logger.Printf("db error (song): %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	song := songRow{
+		ID:          dbSong.ID,
+		Name:        dbSong.Name,
+		FileKey:     dbSong.FileKey,
+		MediaStatus: dbSong.MediaStatus,
+		Audio:       audioInfo(dbSong.DurationMs, dbSong.BitrateKbps, dbSong.SampleRateHz, dbSong.TagTitle, dbSong.TagArtist),
+	}
+	response, err := s.buildSongResponses(ctx, []songRow{song}, argLang)
+	if err != nil {
+		s.logger.Printf("db error (song relations): %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response[0]); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
+
+func (s *Server) GetSongsIdLyrics(w http.ResponseWriter, r *http.Request, id int) {
+	dbLyrics, err := s.db.GetSongLyrics(r.Context(), int64(id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			s.logger.Printf("db error (song lyrics): %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// У песни без текста ресурса нет
+	if dbLyrics.LyricsText == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(api.LyricsResponse{Html: dbLyrics.LyricsHtml, Text: dbLyrics.LyricsText}); err != nil {
+		s.logger.Printf("json encode error: %v", err)
+	}
+}
+
+// GetSongsIdDownload перенаправляет на файл песни, который браузер сохранит под исходным именем
+func (s *Server) GetSongsIdDownload(w http.ResponseWriter, r *http.Request, id int) {
+	ctx := r.Context()
+
+	dbSong, err := s.db.GetSongByID(ctx, db.GetSongByIDParams{ID: int64(id)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			s.logger.Printf("db error (song download): %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if dbSong.FileKey == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	link, err := s.storage.GetDownloadURL(ctx, dbSong.FileKey)
+	if err != nil {
+		s.logger.Printf("storage error (song download): %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, link, http.StatusFound)
+}
+
+// GetSongsIdAudio отдаёт аудио песни через API: ServeContent отвечает на Range частями,
+// поэтому перемотка в браузерном плеере работает без прямого доступа к бакету
+func (s *Server) GetSongsIdAudio(w http.ResponseWriter, r *http.Request, id int, params api.GetSongsIdAudioParams) {
+	ctx := r.Context()
+
+	dbSong, err := s.db.GetSongByID(ctx, db.GetSongByIDParams{ID: int64(id)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			s.logger.Printf("db error (song audio): %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if dbSong.FileKey == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	object, err := s.storage.OpenFile(ctx, dbSong.FileKey)
+	if err != nil {
+		if errors.Is(err, filestorage.ErrFileNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			s.logger.Printf("storage error (song audio): %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+	defer object.Close()
+
+	if isPlaybackStart(r, params.Range) {
+		if err := s.db.IncrementSongPlayCount(ctx, dbSong.ID); err != nil {
+			s.logger.Printf("failed to increment play count for song %d: %v", dbSong.ID, err)
+		}
+	}
+
+	if object.ContentType != "" {
+		w.Header().Set("Content-Type", object.ContentType)
+	}
+	if object.ETag != "" {
+		w.Header().Set("ETag", `"`+object.ETag+`"`)
+	}
+	http.ServeContent(w, r, dbSong.FileKey, object.LastModified, object)
+}
+
+// isPlaybackStart отличает начало прослушивания от перемотки: плеер присылает запрос с начала файла
+// один раз, а дальше докачивает и перематывает запросами с Range. Safari перед этим проверяет
+// поддержку Range запросом bytes=0-1, его не считаем, как и проверку кэша по ETag
+func isPlaybackStart(r *http.Request, rangeHeader *string) bool {
+	if r.Method != http.MethodGet || r.Header.Get("If-None-Match") != "" {
+		return false
+	}
+	if rangeHeader == nil || *rangeHeader == "" {
+		return true
+	}
+	return strings.HasPrefix(*rangeHeader, "bytes=0-") && *rangeHeader != "bytes=0-1"
+}
+
+// songRow — общие поля песни из ListSongs и GetSongByID
+type songRow struct {
+	ID          int64
+	Name        string
+	FileKey     string
+	MediaStatus string
+	Audio       *api.AudioInfo
+}
+
+// audioInfo собирает данные MP3 из колонок песни. Без длительности файл не разобран, и поле не отдаётся
+func audioInfo(durationMs, bitrateKbps, sampleRateHz pgtype.Int4, title, artist string) *api.AudioInfo {
+	if !durationMs.Valid {
+		return nil
+	}
+	info := &api.AudioInfo{
+		DurationMs:   int(durationMs.Int32),
+		BitrateKbps:  int(bitrateKbps.Int32),
+		SampleRateHz: int(sampleRateHz.Int32),
+	}
+	if title != "" {
+		info.Title = &title
+	}
+	if artist != "" {
+		info.Artist = &artist
+	}
+	return info
+}
+
+// buildSongResponses подгружает танцы и ансамбли одним запросом на всю страницу песен
+func (s *Server) buildSongResponses(ctx context.Context, songs []songRow, lang pgtype.Text) ([]api.SongFullResponse, error) {
+	songIDs := make([]int64, len(songs))
+	for i, song := range songs {
+		songIDs[i] = song.ID
+	}
+
+	dbDances, err := s.db.GetDancesBySongIDs(ctx, db.GetDancesBySongIDsParams{Lang: lang, SongIds: songIDs})
+	if err != nil {
+		return nil, err
+	}
+	dbEnsembles, err := s.db.GetEnsemblesBySongIDs(ctx, db.GetEnsemblesBySongIDsParams{Lang: lang, SongIds: songIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	dances := make(map[int64][]api.DanceRefResponse, len(songs))
+	for _, d := range dbDances {
+		dances[d.SongID] = append(dances[d.SongID], api.DanceRefResponse{Id: int(d.ID), Name: d.Name})
+	}
+
+	ensembles := make(map[int64][]api.EnsembleResponse, len(songs))
+	for _, e := range dbEnsembles {
+		ensembles[e.SongID] = append(ensembles[e.SongID], api.EnsembleResponse{Id: int(e.ID), Name: e.Name, Link: e.Link})
+	}
+
+	res := make([]api.SongFullResponse, len(songs))
+	for i, song := range songs {
+		songLink := ""
+		if song.FileKey != "" {
+			songLink, _ = s.storage.GetFileURL(song.FileKey)
+		}
+
+		res[i] = api.SongFullResponse{
+			Id:          int(song.ID),
+			Name:        song.Name,
+			Link:        songLink,
+			MediaStatus: api.MediaStatus(song.MediaStatus),
+			Audio:       song.Audio,
+			Dances:      dances[song.ID],
+			Ensembles:   ensembles[song.ID],
+		}
+		if res[i].Dances == nil {
+			res[i].Dances = []api.DanceRefResponse{}
+		}
+		if res[i].Ensembles == nil {
+			res[i].Ensembles = []api.EnsembleResponse{}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,333 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedSongs(t *testing.T) {
+	ctx := context.Background()
+
+	var danceTransID, song1TransID, song2TransID, artistTransID int64
+	err := testDBPool.QueryRow(ctx, "INSERT INTO translations (eng_name, ru_name) VALUES ('Berd', 'Берд') RETURNING id").Scan(&danceTransID)
+	require.NoError(t, err)
+	err = testDBPool.QueryRow(ctx, "INSERT INTO translations (eng_name, ru_name) VALUES ('Berd Song', 'Песня Берд') RETURNING id").Scan(&song1TransID)
+	require.NoError(t, err)
+	err = testDBPool.QueryRow(ctx, "INSERT INTO translations (eng_name, ru_name) VALUES ('Spring', 'Весна') RETURNING id").Scan(&song2TransID)
+	require.NoError(t, err)
+	err = testDBPool.QueryRow(ctx, "INSERT INTO translations (eng_name, ru_name) VALUES ('Ensemble A', 'Ансамбль А') RETURNING id").Scan(&artistTransID)
+	require.NoError(t, err)
+
+	_, err = testDBPool.Exec(ctx, `
+		INSERT INTO dances (id, translation_id, name, complexity, gender, paces, genres, handshakes)
+		VALUES (1, $1, 'Berd_def', 3, 'male', '{1}', '{"WAR"}', '{"SHOULDER"}')
+	`, danceTransID)
+	require.NoError(t, err)
+
+	_, err = testDBPool.Exec(ctx, `
+		INSERT INTO songs (id, translation_id, file_key, name, lyrics_html, lyrics_text, duration_ms, bitrate_kbps, sample_rate_hz, tag_artist)
+		VALUES (50, $1, 'song.mp3', 'Berd_Song_def', '<b>Берд</b>\nслова', 'Берд\nслова', 215040, 192, 44100, 'Karin')
+	`, song1TransID)
+	require.NoError(t, err)
+	_, err = testDBPool.Exec(ctx, "INSERT INTO songs (id, translation_id, file_key, name, media_status) VALUES (51, $1, '', 'Spring_def', 'MISSING')", song2TransID)
+	require.NoError(t, err)
+	_, err = testDBPool.Exec(ctx, "INSERT INTO dance_song (dance_id, song_id) VALUES (1, 50)")
+	require.NoError(t, err)
+
+	_, err = testDBPool.Exec(ctx, "INSERT INTO artists (id, translation_id, name, link) VALUES (200, $1, 'Ens_def', 'http://ens.com')", artistTransID)
+	require.NoError(t, err)
+	_, err = testDBPool.Exec(ctx, "INSERT INTO song_artist (song_id, artist_id) VALUES (50, 200), (51, 200)")
+	require.NoError(t, err)
+}
+
+func TestGetSongs_Integration(t *testing.T) {
+	clearTables(t)
+	seedSongs(t)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{})
+
+	list := func(t *testing.T, params api.GetSongsParams) api.SongListResponse {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs", nil)
+		w := httptest.NewRecorder()
+		srv.GetSongs(w, req, params)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response api.SongListResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("Success 200 - All Songs", func(t *testing.T) {
+		lang := "ru"
+		response := list(t, api.GetSongsParams{Lang: &lang})
+
+		require.Len(t, response, 2)
+		assert.Equal(t, "Песня Берд", response[0].Name)
+		assert.Equal(t, "http://minio/song.mp3", response[0].Link)
+		assert.Equal(t, api.OK, response[0].MediaStatus)
+		require.Len(t, response[0].Dances, 1)
+		assert.Equal(t, "Берд", response[0].Dances[0].Name)
+		require.Len(t, response[0].Ensembles, 1)
+		assert.Equal(t, "Ансамбль А", response[0].Ensembles[0].Name)
+
+		require.NotNil(t, response[0].Audio)
+		assert.Equal(t, 215040, response[0].Audio.DurationMs)
+
+		assert.Equal(t, "", response[1].Link)
+		assert.Equal(t, api.MISSING, response[1].MediaStatus)
+		assert.Nil(t, response[1].Audio)
+		assert.Empty(t, response[1].Dances)
+	})
+
+	t.Run("Success 200 - Filter By Dance", func(t *testing.T) {
+		danceID := 1
+		response := list(t, api.GetSongsParams{DanceId: &danceID})
+
+		require.Len(t, response, 1)
+		assert.Equal(t, 50, response[0].Id)
+	})
+
+	t.Run("Success 200 - Filter By Ensemble", func(t *testing.T) {
+		ensembleID := 200
+		response := list(t, api.GetSongsParams{EnsembleId: &ensembleID})
+		assert.Len(t, response, 2)
+
+		ensembleID = 999
+		response = list(t, api.GetSongsParams{EnsembleId: &ensembleID})
+		assert.Empty(t, response)
+	})
+
+	t.Run("Success 200 - Search Text", func(t *testing.T) {
+		text := "весна"
+		response := list(t, api.GetSongsParams{SearchText: &text})
+
+		require.Len(t, response, 1)
+		assert.Equal(t, 51, response[0].Id)
+	})
+
+	t.Run("Success 200 - Pagination", func(t *testing.T) {
+		page, size := 2, 1
+		response := list(t, api.GetSongsParams{Page: &page, Size: &size})
+
+		require.Len(t, response, 1)
+		assert.Equal(t, 51, response[0].Id)
+	})
+}
+
+func TestGetSongsId_Integration(t *testing.T) {
+	clearTables(t)
+	seedSongs(t)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{})
+
+	t.Run("Success 200", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/50", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsId(w, req, 50, api.GetSongsIdParams{})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response api.SongFullResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Berd_Song_def", response.Name)
+		require.Len(t, response.Dances, 1)
+		assert.Equal(t, 1, response.Dances[0].Id)
+		require.Len(t, response.Ensembles, 1)
+		assert.Equal(t, "http://ens.com", response.Ensembles[0].Link)
+
+		require.NotNil(t, response.Audio)
+		assert.Equal(t, 215040, response.Audio.DurationMs)
+		assert.Equal(t, 192, response.Audio.BitrateKbps)
+		assert.Equal(t, 44100, response.Audio.SampleRateHz)
+		assert.Nil(t, response.Audio.Title)
+		require.NotNil(t, response.Audio.Artist)
+		assert.Equal(t, "Karin", *response.Audio.Artist)
+	})
+
+	t.Run("Not Found 404", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/999", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsId(w, req, 999, api.GetSongsIdParams{})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetSongsIdLyrics_Integration(t *testing.T) {
+	clearTables(t)
+	seedSongs(t)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{})
+
+	t.Run("Success 200", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/50/lyrics", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdLyrics(w, req, 50)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response api.LyricsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "<b>Берд</b>\nслова", response.Html)
+		assert.Equal(t, "Берд\nслова", response.Text)
+	})
+
+	t.Run("Not Found 404 - No Lyrics", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/51/lyrics", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdLyrics(w, req, 51)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Not Found 404 - No Song", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/999/lyrics", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdLyrics(w, req, 999)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetSongsIdDownload_Integration(t *testing.T) {
+	clearTables(t)
+	seedSongs(t)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{})
+
+	t.Run("Redirect 302", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/50/download", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdDownload(w, req, 50)
+
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "http://minio/song.mp3?download", w.Header().Get("Location"))
+	})
+
+	t.Run("Not Found 404 - No File", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/51/download", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdDownload(w, req, 51)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Not Found 404 - No Song", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/999/download", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdDownload(w, req, 999)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetSongsIdAudio_Integration(t *testing.T) {
+	clearTables(t)
+	seedSongs(t)
+
+	queries := db.New(testDBPool)
+	logger := log.New(io.Discard, "", 0)
+	srv := NewServer(logger, queries, &mockStorage{})
+
+	playCount := func(t *testing.T) int32 {
+		var count int32
+		require.NoError(t, testDBPool.QueryRow(context.Background(), "SELECT play_count FROM songs WHERE id = 50").Scan(&count))
+		return count
+	}
+
+	t.Run("Success 200 - Full File", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/50/audio", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdAudio(w, req, 50, api.GetSongsIdAudioParams{})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, mockAudio, w.Body.String())
+		assert.Equal(t, "audio/mpeg", w.Header().Get("Content-Type"))
+		assert.Equal(t, `"mock-etag"`, w.Header().Get("ETag"))
+		assert.Equal(t, "bytes", w.Header().Get("Accept-Ranges"))
+		assert.Equal(t, int32(1), playCount(t))
+	})
+
+	t.Run("Partial Content 206 - Seek Is Not A Play", func(t *testing.T) {
+		rangeHeader := "bytes=4-8"
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/50/audio", nil)
+		req.Header.Set("Range", rangeHeader)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdAudio(w, req, 50, api.GetSongsIdAudioParams{Range: &rangeHeader})
+
+		assert.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, mockAudio[4:9], w.Body.String())
+		assert.Equal(t, "5", w.Header().Get("Content-Length"))
+		assert.Equal(t, "bytes 4-8/22", w.Header().Get("Content-Range"))
+		assert.Equal(t, int32(1), playCount(t))
+	})
+
+	t.Run("Not Modified 304", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/50/audio", nil)
+		req.Header.Set("If-None-Match", `"mock-etag"`)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdAudio(w, req, 50, api.GetSongsIdAudioParams{})
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Equal(t, int32(1), playCount(t))
+	})
+
+	t.Run("Not Found 404 - No File", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/51/audio", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdAudio(w, req, 51, api.GetSongsIdAudioParams{})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Not Found 404 - No Song", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/songs/999/audio", nil)
+		w := httptest.NewRecorder()
+
+		srv.GetSongsIdAudio(w, req, 999, api.GetSongsIdAudioParams{})
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestIsPlaybackStart(t *testing.T) {
+	header := func(v string) *string { return &v }
+	get := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	assert.True(t, isPlaybackStart(get, nil))
+	assert.True(t, isPlaybackStart(get, header("bytes=0-")))
+	assert.True(t, isPlaybackStart(get, header("bytes=0-4095")))
+	assert.False(t, isPlaybackStart(get, header("bytes=0-1")))
+	assert.False(t, isPlaybackStart(get, header("bytes=1024-")))
+	assert.False(t, isPlaybackStart(httptest.NewRequest(http.MethodHead, "/", nil), nil))
+}
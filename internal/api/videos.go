@@ -0,0 +1,23 @@
+package api
+
+import (
+	api "github.com/Ari-Pari/backend/internal/api/generated"
+	"github.com/Ari-Pari/backend/internal/domain"
+)
+
+// toVideoResponse дополняет ссылки YouTube id ролика, временем старта и ссылкой для iframe,
+// чтобы фронтенду не приходилось разбирать ссылки самому
+func toVideoResponse(id int64, name, link string) api.VideoResponse {
+	res := api.VideoResponse{
+		Id:   int(id),
+		Name: name,
+		Link: link,
+	}
+	if youtube, ok := domain.ParseYouTubeURL(link); ok {
+		embedURL := youtube.EmbedURL()
+		res.YoutubeId = &youtube.ID
+		res.Start = &youtube.StartSeconds
+		res.EmbedUrl = &embedURL
+	}
+	return res
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToVideoResponse(t *testing.T) {
+	t.Run("YouTube", func(t *testing.T) {
+		res := toVideoResponse(1, "Berd", "https://youtu.be/Wk61XUxP2Mg?t=1m30s")
+
+		assert.Equal(t, "https://youtu.be/Wk61XUxP2Mg?t=1m30s", res.Link)
+		require.NotNil(t, res.YoutubeId)
+		assert.Equal(t, "Wk61XUxP2Mg", *res.YoutubeId)
+		assert.Equal(t, 90, *res.Start)
+		assert.Equal(t, "https://www.youtube.com/embed/Wk61XUxP2Mg?start=90", *res.EmbedUrl)
+	})
+
+	t.Run("Other Site", func(t *testing.T) {
+		res := toVideoResponse(2, "Berd", "https://instagram.com/reel/abc")
+
+		assert.Nil(t, res.YoutubeId)
+		assert.Nil(t, res.Start)
+		assert.Nil(t, res.EmbedUrl)
+	})
+}
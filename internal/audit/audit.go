@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Ari-Pari/backend/internal/auth"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ImportActor — автор записей, сделанных автозагрузкой
+const ImportActor = "autoupload"
+
+// unknownActor пишется, если правка пришла мимо проверки прав, например из тестов или скриптов
+const unknownActor = "unknown"
+
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+	// Restore — танец возвращён к одной из своих версий
+	Restore Action = "restore"
+)
+
+func (a Action) Valid() bool {
+	switch a {
+	case Create, Update, Delete, Restore:
+		return true
+	}
+	return false
+}
+
+// EntityTypes — сущности, изменения которых попадают в журнал
+var EntityTypes = []string{"region", "artist", "dance", "song", "video", "group"}
+
+// Change — значение поля до и после правки. Для новой записи Old пустой
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// Entry — одна запись журнала. ID и CreatedAt заполняет база
+type Entry struct {
+	ID          int64
+	CreatedAt   time.Time
+	Actor       string
+	EntityType  string
+	EntityID    int64
+	Action      Action
+	Diff        map[string]Change
+	ImportRunID string
+}
+
+// Fields — снимок записи: имя поля в том виде, в каком его видит API, и значение
+type Fields map[string]any
+
+// Diff оставляет только изменившиеся поля. Значения сравниваются по JSON: так int32 и int64
+// с одним числом не считаются отличием, а поле, которого нет в after, записывается как удалённое
+func Diff(before, after Fields) map[string]Change {
+	changes := make(map[string]Change)
+	for name, newValue := range after {
+		oldValue := before[name]
+		if !sameJSON(oldValue, newValue) {
+			changes[name] = Change{Old: oldValue, New: newValue}
+		}
+	}
+	for name, oldValue := range before {
+		if _, ok := after[name]; !ok && oldValue != nil {
+			changes[name] = Change{Old: oldValue, New: nil}
+		}
+	}
+	return changes
+}
+
+func sameJSON(a, b any) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && bytes.Equal(aJSON, bJSON)
+}
+
+// Actor — кто выполняет запрос: subject из токена или имя API-ключа
+func Actor(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFrom(ctx); ok && principal.Subject != "" {
+		return principal.Subject
+	}
+	return unknownActor
+}
+
+// Inserter — запись в журнал, её умеют и *db.Queries, и db.Querier автозагрузки
+type Inserter interface {
+	InsertAuditLog(ctx context.Context, arg db.InsertAuditLogParams) error
+}
+
+// Record пишет записи одним запросом. Вызывается в той же транзакции, что и сама правка,
+// чтобы изменение и запись о нём сохранялись или откатывались вместе.
+// Правки без изменившихся полей не пишутся
+func Record(ctx context.Context, q Inserter, actor string, importRunID pgtype.UUID, entries ...Entry) error {
+	params := db.InsertAuditLogParams{Actor: actor, ImportRunID: importRunID}
+	for _, e := range entries {
+		if e.Action == Update && len(e.Diff) == 0 {
+			continue
+		}
+		if e.Diff == nil {
+			e.Diff = map[string]Change{}
+		}
+		diff, err := json.Marshal(e.Diff)
+		if err != nil {
+			return fmt.Errorf("encode audit diff of %s %d: %w", e.EntityType, e.EntityID, err)
+		}
+		params.EntityTypes = append(params.EntityTypes, e.EntityType)
+		params.EntityIds = append(params.EntityIds, e.EntityID)
+		params.Actions = append(params.Actions, string(e.Action))
+		params.Diffs = append(params.Diffs, string(diff))
+	}
+	if len(params.EntityIds) == 0 {
+		return nil
+	}
+	return q.InsertAuditLog(ctx, params)
+}
+
+// FromDao разбирает строку журнала из базы
+func FromDao(row db.AuditLog) (Entry, error) {
+	entry := Entry{
+		ID:         row.ID,
+		CreatedAt:  row.CreatedAt.Time,
+		Actor:      row.Actor,
+		EntityType: row.EntityType,
+		EntityID:   row.EntityID,
+		Action:     Action(row.Action),
+	}
+	if err := json.Unmarshal(row.Diff, &entry.Diff); err != nil {
+		return Entry{}, fmt.Errorf("decode audit diff %d: %w", row.ID, err)
+	}
+	if row.ImportRunID.Valid {
+		entry.ImportRunID = row.ImportRunID.String()
+	}
+	return entry, nil
+}
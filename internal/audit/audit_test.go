@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Ari-Pari/backend/internal/auth"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("Only Changed Fields", func(t *testing.T) {
+		before := Fields{"names.hy": "Բերդ", "paces": []int32{1, 2}, "complexity": int32(3)}
+		after := Fields{"names.hy": "Բերդ", "paces": []int32{1, 2, 3}, "complexity": int64(3)}
+
+		assert.Equal(t, map[string]Change{
+			"paces": {Old: []int32{1, 2}, New: []int32{1, 2, 3}},
+		}, Diff(before, after))
+	})
+
+	t.Run("Create Has No Old Values", func(t *testing.T) {
+		diff := Diff(nil, Fields{"link": "https://www.youtube.com/watch?v=Wk61XUxP2Mg", "complexity": nil})
+
+		assert.Equal(t, map[string]Change{
+			"link": {Old: nil, New: "https://www.youtube.com/watch?v=Wk61XUxP2Mg"},
+		}, diff)
+	})
+
+	t.Run("Removed Field", func(t *testing.T) {
+		assert.Equal(t, map[string]Change{
+			"fileKey": {Old: "audio-1", New: nil},
+		}, Diff(Fields{"fileKey": "audio-1"}, Fields{}))
+	})
+}
+
+func TestActor(t *testing.T) {
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{Subject: "editor@example.com"})
+	assert.Equal(t, "editor@example.com", Actor(ctx))
+	assert.Equal(t, "unknown", Actor(context.Background()))
+}
+
+type inserterFunc func(ctx context.Context, arg db.InsertAuditLogParams) error
+
+func (f inserterFunc) InsertAuditLog(ctx context.Context, arg db.InsertAuditLogParams) error {
+	return f(ctx, arg)
+}
+
+func TestRecord(t *testing.T) {
+	t.Run("Skips Updates Without Changes", func(t *testing.T) {
+		var got []db.InsertAuditLogParams
+		q := inserterFunc(func(_ context.Context, arg db.InsertAuditLogParams) error {
+			got = append(got, arg)
+			return nil
+		})
+
+		err := Record(context.Background(), q, "editor", pgtype.UUID{},
+			Entry{EntityType: "dance", EntityID: 1, Action: Update},
+			Entry{EntityType: "dance", EntityID: 2, Action: Delete},
+			Entry{EntityType: "song", EntityID: 3, Action: Update, Diff: map[string]Change{"names.en": {Old: "", New: "Song"}}},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got, 1)
+		assert.Equal(t, "editor", got[0].Actor)
+		assert.Equal(t, []string{"dance", "song"}, got[0].EntityTypes)
+		assert.Equal(t, []int64{2, 3}, got[0].EntityIds)
+		assert.Equal(t, []string{"delete", "update"}, got[0].Actions)
+		assert.Equal(t, "{}", got[0].Diffs[0])
+		assert.JSONEq(t, `{"names.en": {"old": "", "new": "Song"}}`, got[0].Diffs[1])
+	})
+
+	t.Run("Nothing To Write", func(t *testing.T) {
+		q := inserterFunc(func(context.Context, db.InsertAuditLogParams) error {
+			t.Fatal("insert must not be called")
+			return nil
+		})
+		require.NoError(t, Record(context.Background(), q, "editor", pgtype.UUID{},
+			Entry{EntityType: "dance", EntityID: 1, Action: Update, Diff: map[string]Change{}}))
+	})
+}
+
+func TestFromDao(t *testing.T) {
+	var runID pgtype.UUID
+	require.NoError(t, runID.Scan("7d3c6a9e-5b1f-4d2a-9c8e-0f1e2d3c4b5a"))
+	diff, err := json.Marshal(map[string]Change{"deleted": {Old: "false", New: "true"}})
+	require.NoError(t, err)
+
+	entry, err := FromDao(db.AuditLog{ID: 5, Actor: ImportActor, EntityType: "video", EntityID: 9, Action: "delete", Diff: diff, ImportRunID: runID})
+	require.NoError(t, err)
+
+	assert.Equal(t, Delete, entry.Action)
+	assert.Equal(t, "7d3c6a9e-5b1f-4d2a-9c8e-0f1e2d3c4b5a", entry.ImportRunID)
+	assert.Equal(t, map[string]Change{"deleted": {Old: "false", New: "true"}}, entry.Diff)
+}
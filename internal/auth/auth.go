@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrNoCredentials — в запросе нет ни токена, ни ключа
+var ErrNoCredentials = errors.New("no credentials")
+
+// ErrInvalidCredentials — токен или ключ не прошли проверку
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// APIKeyHeader — заголовок для ключей сервисов, которым неудобно выпускать JWT
+const APIKeyHeader = "X-API-Key"
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+)
+
+type Role string
+
+const (
+	// RoleEditor создаёт и правит записи
+	RoleEditor Role = "editor"
+	// RoleAdmin может всё, что editor, и сверх того удалять записи
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) Valid() bool {
+	switch r {
+	case RoleEditor, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// Principal — кто выполняет запрос: sub из токена или имя ключа
+type Principal struct {
+	Subject string
+	Roles   []Role
+}
+
+// HasRole учитывает, что admin включает права editor
+func (p Principal) HasRole(role Role) bool {
+	return slices.Contains(p.Roles, role) || slices.Contains(p.Roles, RoleAdmin)
+}
+
+type APIKey struct {
+	Name string
+	Role Role
+	Key  string
+}
+
+type Options struct {
+	// JWTAlgorithm — HS256 или RS256. Пустой отключает JWT
+	JWTAlgorithm string
+	// JWTKey — секрет для HS256 или открытый ключ в PEM для RS256
+	JWTKey []byte
+	// JWTIssuer проверяется, если задан
+	JWTIssuer string
+	APIKeys   []APIKey
+}
+
+type Authenticator interface {
+	// Authenticate читает Authorization: Bearer или X-API-Key
+	Authenticate(r *http.Request) (Principal, error)
+}
+
+func NewAuthenticator(opts Options) (Authenticator, error) {
+	a := &authenticator{apiKeys: opts.APIKeys, issuer: opts.JWTIssuer}
+	switch opts.JWTAlgorithm {
+	case "":
+	case AlgorithmHS256:
+		if len(opts.JWTKey) == 0 {
+			return nil, errors.New("HS256 requires a secret")
+		}
+		a.method, a.key = jwt.SigningMethodHS256, opts.JWTKey
+	case AlgorithmRS256:
+		key, err := jwt.ParseRSAPublicKeyFromPEM(opts.JWTKey)
+		if err != nil {
+			return nil, fmt.Errorf("parse RS256 public key: %w", err)
+		}
+		a.method, a.key = jwt.SigningMethodRS256, key
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q", opts.JWTAlgorithm)
+	}
+	for _, key := range opts.APIKeys {
+		if !key.Role.Valid() {
+			return nil, fmt.Errorf("api key %q: unknown role %q", key.Name, key.Role)
+		}
+	}
+	return a, nil
+}
+
+type authenticator struct {
+	method  jwt.SigningMethod
+	key     any
+	issuer  string
+	apiKeys []APIKey
+}
+
+// claims — роли лежат в claim roles рядом со стандартными
+type claims struct {
+	Roles []Role `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+func (a *authenticator) Authenticate(r *http.Request) (Principal, error) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return a.authenticateAPIKey(key)
+	}
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return a.authenticateJWT(strings.TrimSpace(token))
+	}
+	return Principal{}, ErrNoCredentials
+}
+
+func (a *authenticator) authenticateAPIKey(key string) (Principal, error) {
+	for _, k := range a.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(k.Key)) == 1 {
+			return Principal{Subject: "api-key:" + k.Name, Roles: []Role{k.Role}}, nil
+		}
+	}
+	return Principal{}, ErrInvalidCredentials
+}
+
+func (a *authenticator) authenticateJWT(raw string) (Principal, error) {
+	if a.method == nil {
+		return Principal{}, ErrInvalidCredentials
+	}
+
+	// exp обязателен: бессрочный токен нельзя отозвать без смены ключа
+	opts := []jwt.ParserOption{jwt.WithValidMethods([]string{a.method.Alg()}), jwt.WithExpirationRequired()}
+	if a.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(a.issuer))
+	}
+	var c claims
+	_, err := jwt.ParseWithClaims(raw, &c, func(*jwt.Token) (any, error) { return a.key, nil }, opts...)
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	principal := Principal{Subject: c.Subject}
+	for _, role := range c.Roles {
+		if role.Valid() {
+			principal.Roles = append(principal.Roles, role)
+		}
+	}
+	return principal, nil
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom возвращает пользователя, если запрос прошёл проверку прав
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secret = []byte("test-secret")
+
+func signHS256(t *testing.T, c claims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(secret)
+	require.NoError(t, err)
+	return token
+}
+
+func bearerRequest(token string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/dances", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func validClaims() claims {
+	return claims{
+		Roles: []Role{RoleEditor, "viewer"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "anna",
+			Issuer:    "ari-pari",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func TestAuthenticate_HS256(t *testing.T) {
+	authn, err := NewAuthenticator(Options{JWTAlgorithm: AlgorithmHS256, JWTKey: secret, JWTIssuer: "ari-pari"})
+	require.NoError(t, err)
+
+	t.Run("valid token", func(t *testing.T) {
+		principal, err := authn.Authenticate(bearerRequest(signHS256(t, validClaims())))
+
+		require.NoError(t, err)
+		assert.Equal(t, Principal{Subject: "anna", Roles: []Role{RoleEditor}}, principal)
+	})
+
+	t.Run("rejected tokens", func(t *testing.T) {
+		expired := validClaims()
+		expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+		noExpiry := validClaims()
+		noExpiry.ExpiresAt = nil
+		otherIssuer := validClaims()
+		otherIssuer.Issuer = "someone-else"
+		otherSecret, err := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("other"))
+		require.NoError(t, err)
+		// alg none нельзя принимать ни при каких настройках
+		unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+
+		for name, token := range map[string]string{
+			"expired":      signHS256(t, expired),
+			"no expiry":    signHS256(t, noExpiry),
+			"other issuer": signHS256(t, otherIssuer),
+			"other secret": otherSecret,
+			"alg none":     unsigned,
+			"garbage":      "not-a-jwt",
+		} {
+			_, err := authn.Authenticate(bearerRequest(token))
+			assert.ErrorIs(t, err, ErrInvalidCredentials, name)
+		}
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		_, err := authn.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.ErrorIs(t, err, ErrNoCredentials)
+	})
+}
+
+func TestAuthenticate_RS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	authn, err := NewAuthenticator(Options{JWTAlgorithm: AlgorithmRS256, JWTKey: publicPEM})
+	require.NoError(t, err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, validClaims()).SignedString(key)
+	require.NoError(t, err)
+	principal, err := authn.Authenticate(bearerRequest(token))
+	require.NoError(t, err)
+	assert.Equal(t, "anna", principal.Subject)
+
+	// HS256 с открытым ключом в роли секрета — классическая подмена алгоритма
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString(publicPEM)
+	require.NoError(t, err)
+	_, err = authn.Authenticate(bearerRequest(forged))
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestAuthenticate_APIKey(t *testing.T) {
+	authn, err := NewAuthenticator(Options{APIKeys: []APIKey{{Name: "importer", Role: RoleAdmin, Key: "k-123"}}})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/dances/1", nil)
+	req.Header.Set(APIKeyHeader, "k-123")
+	principal, err := authn.Authenticate(req)
+	require.NoError(t, err)
+	assert.Equal(t, Principal{Subject: "api-key:importer", Roles: []Role{RoleAdmin}}, principal)
+
+	req.Header.Set(APIKeyHeader, "k-124")
+	_, err = authn.Authenticate(req)
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	// Без настроенного JWT любой токен отклоняется
+	_, err = authn.Authenticate(bearerRequest("anything"))
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestNewAuthenticator_InvalidOptions(t *testing.T) {
+	for name, opts := range map[string]Options{
+		"unknown algorithm": {JWTAlgorithm: "ES256"},
+		"empty secret":      {JWTAlgorithm: AlgorithmHS256},
+		"bad public key":    {JWTAlgorithm: AlgorithmRS256, JWTKey: []byte("not pem")},
+		"unknown role":      {APIKeys: []APIKey{{Name: "ci", Role: "owner", Key: "k"}}},
+	} {
+		_, err := NewAuthenticator(opts)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestPrincipal_HasRole(t *testing.T) {
+	editor := Principal{Roles: []Role{RoleEditor}}
+	admin := Principal{Roles: []Role{RoleAdmin}}
+
+	assert.True(t, editor.HasRole(RoleEditor))
+	assert.False(t, editor.HasRole(RoleAdmin))
+	assert.True(t, admin.HasRole(RoleEditor))
+	assert.True(t, admin.HasRole(RoleAdmin))
+	assert.False(t, Principal{}.HasRole(RoleEditor))
+}
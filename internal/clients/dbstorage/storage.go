@@ -22,6 +22,10 @@ func New(ctx context.Context, dsn string) (*Storage, error) {
 	}, nil
 }
 
+func (s *Storage) Ping(ctx context.Context) error {
+	return s.Pool.Ping(ctx)
+}
+
 func (s *Storage) Close() {
 	s.Pool.Close()
 }
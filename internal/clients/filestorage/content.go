@@ -0,0 +1,67 @@
+package filestorage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// HashMetadataKey — метаданные объекта с SHA-256 содержимого
+const HashMetadataKey = "Sha256"
+
+// contentFile — загружаемое содержимое, сохранённое во временный файл:
+// ключ зависит от хеша, поэтому его нужно дочитать до конца ещё до загрузки
+type contentFile struct {
+	file *os.File
+	hash string
+	size int64
+}
+
+func bufferContent(reader io.Reader) (*contentFile, error) {
+	file, err := os.CreateTemp("", "upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temp file: %w", err)
+	}
+	content := &contentFile{file: file}
+
+	hash := sha256.New()
+	content.size, err = io.Copy(io.MultiWriter(file, hash), reader)
+	if err != nil {
+		_ = content.Close()
+		return nil, fmt.Errorf("read upload: %w", err)
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		_ = content.Close()
+		return nil, err
+	}
+	content.hash = hex.EncodeToString(hash.Sum(nil))
+
+	return content, nil
+}
+
+func (c *contentFile) Read(p []byte) (int, error) {
+	return c.file.Read(p)
+}
+
+func (c *contentFile) Close() error {
+	err := c.file.Close()
+	_ = os.Remove(c.file.Name())
+	return err
+}
+
+// contentKey строит ключ объекта из хеша содержимого и расширения исходного файла.
+// Одинаковые файлы получают один ключ, поэтому повторная загрузка ничего не добавляет в хранилище
+func contentKey(hash, originalName string) string {
+	return hash + strings.ToLower(filepath.Ext(originalName))
+}
+
+// ContentKey возвращает ключ, под которым UploadFile сохранит data. По нему можно заранее узнать,
+// есть ли такой файл в хранилище
+func ContentKey(data []byte, originalName string) string {
+	sum := sha256.Sum256(data)
+	return contentKey(hex.EncodeToString(sum[:]), originalName)
+}
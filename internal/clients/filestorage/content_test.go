@@ -0,0 +1,52 @@
+package filestorage
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferContent(t *testing.T) {
+	content, err := bufferContent(strings.NewReader("hello"))
+	require.NoError(t, err)
+
+	// sha256("hello")
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", content.hash)
+	assert.Equal(t, int64(5), content.size)
+
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	name := content.file.Name()
+	require.NoError(t, content.Close())
+	_, err = os.Stat(name)
+	assert.True(t, os.IsNotExist(err), "temp file should be removed on Close")
+}
+
+func TestContentKey(t *testing.T) {
+	assert.Equal(t, "abc.mp3", contentKey("abc", "static/music/Երգ.MP3"))
+	assert.Equal(t, "abc.jpeg", contentKey("abc", "12.jpeg"))
+	assert.Equal(t, "abc", contentKey("abc", "noext"))
+}
+
+func TestContentKey_MatchesUpload(t *testing.T) {
+	storage, err := NewLocalStorage(t.TempDir(), "http://localhost/files")
+	require.NoError(t, err)
+
+	key, err := storage.UploadFile(context.Background(), "song.MP3", strings.NewReader("hello"), 5, "audio/mpeg")
+	require.NoError(t, err)
+
+	assert.Equal(t, key, ContentKey([]byte("hello"), "song.MP3"))
+}
+
+func TestAttachmentDisposition(t *testing.T) {
+	assert.Equal(t, "attachment; filename=song.mp3", attachmentDisposition("static/music/song.mp3", "abc.mp3"))
+	assert.Equal(t, `attachment; filename*=utf-8''%D4%B5%D6%80%D5%A3.mp3`, attachmentDisposition("static\\music\\Երգ.mp3", "abc.mp3"))
+	assert.Equal(t, "attachment; filename=abc.mp3", attachmentDisposition("", "abc.mp3"))
+}
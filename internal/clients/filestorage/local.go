@@ -0,0 +1,230 @@
+package filestorage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// metaSuffix — расширение файла с метаданными рядом с объектом
+	metaSuffix = ".meta.json"
+	// downloadParam — параметр ссылки, с которым LocalFileServer отдаёт файл под исходным именем
+	downloadParam = "download"
+)
+
+// localMeta — то, что MinIO хранит в метаданных объекта
+type localMeta struct {
+	OriginalName string `json:"originalName"`
+	ContentType  string `json:"contentType"`
+	Sha256       string `json:"sha256"`
+}
+
+// localStorage хранит объекты в папке на диске, для разработки без MinIO
+type localStorage struct {
+	dir           string
+	publicBaseURL string
+}
+
+// NewLocalStorage создаёт папку dir, если её нет. publicBaseURL — адрес, по которому
+// cmd/app раздаёт эту папку через LocalFileServer
+func NewLocalStorage(dir, publicBaseURL string) (FileStorage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage dir: %w", err)
+	}
+	return &localStorage{dir: dir, publicBaseURL: publicBaseURL}, nil
+}
+
+// UploadFile, как и в MinIO, кладёт объект под ключом из SHA-256 содержимого и не перезаписывает существующий
+func (s *localStorage) UploadFile(_ context.Context, originalName string, reader io.Reader, _ int64, contentType string) (string, error) {
+	content, err := bufferContent(reader)
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+
+	fileKey := contentKey(content.hash, originalName)
+	path := filepath.Join(s.dir, fileKey)
+	if _, err = os.Stat(path); err == nil {
+		// Повторная загрузка обновляет время объекта, чтобы сборщик медиа не удалил файл, на который сейчас сошлются
+		now := time.Now()
+		return fileKey, os.Chtimes(path, now, now)
+	}
+
+	meta, err := json.Marshal(localMeta{OriginalName: originalName, ContentType: contentType, Sha256: content.hash})
+	if err != nil {
+		return "", err
+	}
+	if err = os.WriteFile(path+metaSuffix, meta, 0o644); err != nil {
+		return "", err
+	}
+
+	// Пишем во временный файл и переименовываем, чтобы недописанный объект не попал в раздачу
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = io.Copy(tmp, content); err != nil {
+		_ = tmp.Close()
+		return "", err
+	}
+	if err = tmp.Close(); err != nil {
+		return "", err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+
+	return fileKey, nil
+}
+
+func (s *localStorage) GetFileURL(fileKey string) (string, error) {
+	return url.JoinPath(s.publicBaseURL, fileKey)
+}
+
+// GetDownloadURL — аналог подписанной ссылки MinIO с Content-Disposition, но без срока действия
+func (s *localStorage) GetDownloadURL(_ context.Context, fileKey string) (string, error) {
+	link, err := s.GetFileURL(fileKey)
+	if err != nil {
+		return "", err
+	}
+	return link + "?" + downloadParam + "=1", nil
+}
+
+func (s *localStorage) DeleteFile(_ context.Context, fileKey string) error {
+	path := filepath.Join(s.dir, filepath.Base(fileKey))
+	// Как и RemoveObject в MinIO, удаление отсутствующего объекта не ошибка
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.Remove(path + metaSuffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *localStorage) OpenFile(_ context.Context, fileKey string) (*Object, error) {
+	path := filepath.Join(s.dir, filepath.Base(fileKey))
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrFileNotFound, fileKey)
+		}
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	// Ключ и есть хэш содержимого, поэтому он же годится как ETag, если метаданных нет
+	object := &Object{ReadSeekCloser: file, Size: info.Size(), ETag: fileKey, LastModified: info.ModTime()}
+	if meta, err := readLocalMeta(path); err == nil {
+		object.ContentType = meta.ContentType
+		if meta.Sha256 != "" {
+			object.ETag = meta.Sha256
+		}
+	}
+	return object, nil
+}
+
+func (s *localStorage) GetOriginalName(_ context.Context, fileKey string) (string, error) {
+	meta, err := readLocalMeta(filepath.Join(s.dir, filepath.Base(fileKey)))
+	if err != nil {
+		return "", err
+	}
+	return meta.OriginalName, nil
+}
+
+func (s *localStorage) ListFiles(_ context.Context) ([]FileInfo, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []FileInfo
+	for _, entry := range entries {
+		if entry.IsDir() || isServiceFile(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, FileInfo{Key: entry.Name(), Size: info.Size(), LastModified: info.ModTime()})
+	}
+	return files, nil
+}
+
+func (s *localStorage) Ping(_ context.Context) error {
+	info, err := os.Stat(s.dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("storage path '%s' is not a directory", s.dir)
+	}
+	return nil
+}
+
+// LocalFileServer раздаёт объекты NewLocalStorage с Content-Type из метаданных.
+// Файлы метаданных и недописанные загрузки наружу не отдаются
+func LocalFileServer(dir string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := filepath.Base(r.URL.Path)
+		if name == "." || name == "/" || isServiceFile(name) {
+			http.NotFound(w, r)
+			return
+		}
+
+		path := filepath.Join(dir, name)
+		file, err := os.Open(path)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil || info.IsDir() {
+			http.NotFound(w, r)
+			return
+		}
+
+		if meta, err := readLocalMeta(path); err == nil {
+			if meta.ContentType != "" {
+				w.Header().Set("Content-Type", meta.ContentType)
+			}
+			if r.URL.Query().Has(downloadParam) {
+				w.Header().Set("Content-Disposition", attachmentDisposition(meta.OriginalName, name))
+			}
+		}
+		http.ServeContent(w, r, name, info.ModTime(), file)
+	})
+}
+
+func readLocalMeta(path string) (localMeta, error) {
+	data, err := os.ReadFile(path + metaSuffix)
+	if err != nil {
+		return localMeta{}, err
+	}
+	var meta localMeta
+	if err = json.Unmarshal(data, &meta); err != nil {
+		return localMeta{}, fmt.Errorf("failed to decode metadata of %s: %w", path, err)
+	}
+	return meta, nil
+}
+
+func isServiceFile(name string) bool {
+	return strings.HasSuffix(name, metaSuffix) || strings.HasPrefix(name, ".")
+}
@@ -0,0 +1,98 @@
+package filestorage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const helloKey = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824.mp3"
+
+func TestLocalStorage_UploadAndDedupe(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage, err := NewLocalStorage(dir, "http://localhost:8080/files")
+	require.NoError(t, err)
+
+	key, err := storage.UploadFile(ctx, "static/music/Երգ.mp3", strings.NewReader("hello"), 5, "audio/mpeg")
+	require.NoError(t, err)
+	assert.Equal(t, helloKey, key)
+
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, key), old, old))
+
+	again, err := storage.UploadFile(ctx, "other.MP3", strings.NewReader("hello"), 5, "audio/mpeg")
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	// Повторная загрузка освежает объект для сборщика медиа
+	info, err := os.Stat(filepath.Join(dir, key))
+	require.NoError(t, err)
+	assert.True(t, info.ModTime().After(old.Add(time.Hour)))
+
+	data, err := os.ReadFile(filepath.Join(dir, key))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	// Метаданные остаются от первой загрузки
+	name, err := storage.GetOriginalName(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "static/music/Երգ.mp3", name)
+
+	link, err := storage.GetFileURL(key)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/files/"+key, link)
+
+	files, err := storage.ListFiles(ctx)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, key, files[0].Key)
+	assert.Equal(t, int64(5), files[0].Size)
+
+	require.NoError(t, storage.DeleteFile(ctx, key))
+	require.NoError(t, storage.DeleteFile(ctx, key))
+	files, err = storage.ListFiles(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+	_, err = os.Stat(filepath.Join(dir, key+metaSuffix))
+	assert.True(t, os.IsNotExist(err), "metadata should be removed with the object")
+
+	require.NoError(t, storage.Ping(ctx))
+}
+
+func TestLocalFileServer(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := NewLocalStorage(dir, "http://localhost:8080/files")
+	require.NoError(t, err)
+	key, err := storage.UploadFile(context.Background(), "song.mp3", strings.NewReader("hello"), 5, "audio/mpeg")
+	require.NoError(t, err)
+
+	handler := http.StripPrefix("/files/", LocalFileServer(dir))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/files/"+key, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "audio/mpeg", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "hello", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	download, err := storage.GetDownloadURL(context.Background(), key)
+	require.NoError(t, err)
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, strings.TrimPrefix(download, "http://localhost:8080"), nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "attachment; filename=song.mp3", rec.Header().Get("Content-Disposition"))
+
+	for _, path := range []string{"/files/" + key + metaSuffix, "/files/missing.mp3", "/files/.upload-123"} {
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code, path)
+	}
+}
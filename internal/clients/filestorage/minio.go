@@ -2,14 +2,16 @@ package filestorage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"net/url"
-	"path/filepath"
+	"path"
 	"strings"
+	"time"
 
-	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
@@ -18,8 +20,31 @@ import (
 type FileStorage interface {
 	UploadFile(ctx context.Context, originalName string, reader io.Reader, fileSize int64, contentType string) (string, error)
 	GetFileURL(fileKey string) (string, error)
+	GetDownloadURL(ctx context.Context, fileKey string) (string, error)
+	OpenFile(ctx context.Context, fileKey string) (*Object, error)
 	DeleteFile(ctx context.Context, fileKey string) error
 	GetOriginalName(ctx context.Context, fileKey string) (string, error)
+	ListFiles(ctx context.Context) ([]FileInfo, error)
+	Ping(ctx context.Context) error
+}
+
+// ErrFileNotFound — в хранилище нет объекта с таким ключом
+var ErrFileNotFound = errors.New("file not found")
+
+// Object — открытый объект для потоковой отдачи. Seek нужен для ответов на запросы с Range
+type Object struct {
+	io.ReadSeekCloser
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+// FileInfo — объект хранилища в выдаче ListFiles
+type FileInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
 }
 
 // minioStorage — внутренняя реализация интерфейса для MinIO
@@ -27,10 +52,12 @@ type minioStorage struct {
 	client, publicClient *minio.Client
 	publicURL            string
 	bucketName           string
+	urlExpiry            time.Duration
 }
 
-// NewMinioStorage возвращает интерфейс FileStorage
-func NewMinioStorage(ctx context.Context, endpoint, serverURL, accessKey, secretKey, bucket string, useSSL bool) (FileStorage, error) {
+// NewMinioStorage возвращает интерфейс FileStorage. При urlExpiry > 0 ссылки на файлы
+// подписываются и действуют urlExpiry, иначе ведут прямо в бакет и требуют анонимного доступа к нему
+func NewMinioStorage(ctx context.Context, endpoint, serverURL, accessKey, secretKey, bucket string, useSSL bool, urlExpiry time.Duration) (FileStorage, error) {
 	endpoint = strings.TrimPrefix(endpoint, "http://")
 	endpoint = strings.TrimPrefix(endpoint, "https://")
 	client, err := minio.New(endpoint, &minio.Options{
@@ -56,25 +83,75 @@ func NewMinioStorage(ctx context.Context, endpoint, serverURL, accessKey, secret
 	} else {
 		log.Printf("Bucket '%s' already exists", bucket)
 	}
-	return &minioStorage{
+
+	storage := &minioStorage{
 		client:     client,
 		bucketName: bucket,
 		publicURL:  serverURL,
-	}, nil
+		urlExpiry:  urlExpiry,
+	}
+	if urlExpiry > 0 {
+		storage.publicClient, err = newPresignClient(ctx, client, serverURL, accessKey, secretKey, bucket)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return storage, nil
 }
 
+// newPresignClient создаёт клиент на публичный адрес: подпись включает хост, поэтому подписывать
+// ссылки внутренним endpoint нельзя. Регион задаём заранее, чтобы подпись не ходила в сеть
+func newPresignClient(ctx context.Context, client *minio.Client, serverURL, accessKey, secretKey, bucket string) (*minio.Client, error) {
+	public, err := url.Parse(serverURL)
+	if err != nil || public.Host == "" {
+		return nil, fmt.Errorf("invalid minio server url '%s'", serverURL)
+	}
+
+	region, err := client.GetBucketLocation(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket location: %w", err)
+	}
+
+	publicClient, err := minio.New(public.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: public.Scheme == "https",
+		Region: region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create minio presign client: %w", err)
+	}
+	return publicClient, nil
+}
+
+// UploadFile сохраняет файл под ключом из SHA-256 содержимого. Если такой объект уже есть,
+// повторной загрузки не происходит. Один объект могут разделять несколько записей,
+// поэтому удалять его можно только когда на ключ больше никто не ссылается
 func (s *minioStorage) UploadFile(ctx context.Context, originalName string, reader io.Reader, fileSize int64, contentType string) (string, error) {
-	ext := filepath.Ext(originalName)
-	fileKey := uuid.New().String() + ext
+	content, err := bufferContent(reader)
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+
+	fileKey := contentKey(content.hash, originalName)
+
+	info, err := s.client.StatObject(ctx, s.bucketName, fileKey, minio.StatObjectOptions{})
+	if err == nil {
+		return fileKey, s.touch(ctx, fileKey, info)
+	}
+	if minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		return "", err
+	}
 
 	opts := minio.PutObjectOptions{
 		ContentType: contentType,
 		UserMetadata: map[string]string{
 			"Original-Name": originalName,
+			HashMetadataKey: content.hash,
 		},
 	}
 
-	_, err := s.client.PutObject(ctx, s.bucketName, fileKey, reader, fileSize, opts)
+	_, err = s.client.PutObject(ctx, s.bucketName, fileKey, content, content.size, opts)
 	if err != nil {
 		return "", err
 	}
@@ -82,10 +159,64 @@ func (s *minioStorage) UploadFile(ctx context.Context, originalName string, read
 	return fileKey, nil
 }
 
+// touch копирует объект сам в себя, чтобы обновить LastModified: повторная загрузка того же содержимого
+// не должна выглядеть для сборщика медиа старым объектом. S3 разрешает такую копию только с заменой метаданных
+func (s *minioStorage) touch(ctx context.Context, fileKey string, info minio.ObjectInfo) error {
+	_, err := s.client.CopyObject(ctx,
+		minio.CopyDestOptions{
+			Bucket:          s.bucketName,
+			Object:          fileKey,
+			UserMetadata:    info.UserMetadata,
+			ReplaceMetadata: true,
+			ContentType:     info.ContentType,
+		},
+		minio.CopySrcOptions{Bucket: s.bucketName, Object: fileKey},
+	)
+	return err
+}
+
 func (s *minioStorage) GetFileURL(fileKey string) (string, error) {
+	if s.urlExpiry > 0 {
+		return s.presign(context.Background(), fileKey, nil)
+	}
 	return url.JoinPath(s.publicURL, s.bucketName, fileKey)
 }
 
+// GetDownloadURL отдаёт ссылку, по которой файл скачивается под исходным именем из Original-Name.
+// Переопределить заголовки ответа можно только подписанной ссылкой, поэтому без urlExpiry
+// возвращается обычная ссылка
+func (s *minioStorage) GetDownloadURL(ctx context.Context, fileKey string) (string, error) {
+	if s.urlExpiry <= 0 {
+		return s.GetFileURL(fileKey)
+	}
+
+	originalName, err := s.GetOriginalName(ctx, fileKey)
+	if err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	params.Set("response-content-disposition", attachmentDisposition(originalName, fileKey))
+	return s.presign(ctx, fileKey, params)
+}
+
+func (s *minioStorage) presign(ctx context.Context, fileKey string, params url.Values) (string, error) {
+	link, err := s.publicClient.PresignedGetObject(ctx, s.bucketName, fileKey, s.urlExpiry, params)
+	if err != nil {
+		return "", err
+	}
+	return link.String(), nil
+}
+
+// attachmentDisposition строит Content-Disposition для скачивания. Имена бывают на армянском,
+// FormatMediaType кодирует их по RFC 2231
+func attachmentDisposition(originalName, fileKey string) string {
+	name := path.Base(strings.ReplaceAll(originalName, "\\", "/"))
+	if name == "." || name == "/" {
+		name = fileKey
+	}
+	return mime.FormatMediaType("attachment", map[string]string{"filename": name})
+}
+
 func (s *minioStorage) GetOriginalName(ctx context.Context, fileKey string) (string, error) {
 	info, err := s.client.StatObject(ctx, s.bucketName, fileKey, minio.StatObjectOptions{})
 	if err != nil {
@@ -94,6 +225,54 @@ func (s *minioStorage) GetOriginalName(ctx context.Context, fileKey string) (str
 	return info.UserMetadata["Original-Name"], nil
 }
 
+// OpenFile открывает объект на чтение. Данные скачиваются по мере чтения, с позиции последнего Seek
+func (s *minioStorage) OpenFile(ctx context.Context, fileKey string) (*Object, error) {
+	object, err := s.client.GetObject(ctx, s.bucketName, fileKey, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	info, err := object.Stat()
+	if err != nil {
+		_ = object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%w: %s", ErrFileNotFound, fileKey)
+		}
+		return nil, err
+	}
+
+	return &Object{
+		ReadSeekCloser: object,
+		Size:           info.Size,
+		ContentType:    info.ContentType,
+		ETag:           info.ETag,
+		LastModified:   info.LastModified,
+	}, nil
+}
+
+// ListFiles возвращает все объекты бакета
+func (s *minioStorage) ListFiles(ctx context.Context) ([]FileInfo, error) {
+	var files []FileInfo
+	for object := range s.client.ListObjects(ctx, s.bucketName, minio.ListObjectsOptions{Recursive: true}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		files = append(files, FileInfo{Key: object.Key, Size: object.Size, LastModified: object.LastModified})
+	}
+	return files, nil
+}
+
 func (s *minioStorage) DeleteFile(ctx context.Context, fileKey string) error {
 	return s.client.RemoveObject(ctx, s.bucketName, fileKey, minio.RemoveObjectOptions{})
 }
+
+// Ping проверяет, что MinIO отвечает и бакет существует
+func (s *minioStorage) Ping(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucketName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("bucket '%s' does not exist", s.bucketName)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 )
 
 type PostgresConfig struct {
@@ -16,14 +18,56 @@ type MinioConfig struct {
 	Bucket    string
 	UseSSL    bool
 	ServerURL string
+	// URLExpiry — срок действия подписанных ссылок на файлы, 0 — публичные ссылки без подписи
+	URLExpiry time.Duration
+}
+
+const (
+	StorageDriverMinio = "minio"
+	StorageDriverLocal = "local"
+)
+
+// StorageConfig выбирает хранилище файлов. Для local настройки MinIO не нужны
+type StorageConfig struct {
+	Driver         string
+	LocalDir       string
+	LocalPublicURL string
+}
+
+// TelegramConfig — доступ к Bot API для скачивания аудио по fileId из musics.json
+type TelegramConfig struct {
+	BotToken string
+	APIURL   string
+}
+
+// AuthConfig — проверка JWT и ключей для записи. Если не задано ни то, ни другое, запись закрыта
+type AuthConfig struct {
+	// JWTAlgorithm — HS256 или RS256, пустой отключает JWT
+	JWTAlgorithm string
+	// JWTKey — секрет для HS256 или содержимое PEM-файла с открытым ключом для RS256
+	JWTKey    []byte
+	JWTIssuer string
+	APIKeys   []APIKeyConfig
+}
+
+type APIKeyConfig struct {
+	Name string
+	Role string
+	Key  string
 }
 
 type Config struct {
 	Postgres              PostgresConfig
 	PostgresAutoUpload    PostgresConfig
+	Storage               StorageConfig
 	Minio                 MinioConfig
+	Telegram              TelegramConfig
 	MusicFolderPath       string
+	MusicFileIdFolderPath string
 	DancePhotosFolderPath string
+	// CWebPPath — cwebp для WebP-копий фото, без него копии делаются только в JPEG
+	CWebPPath string
+	Auth      AuthConfig
 }
 
 func Load() (*Config, error) {
@@ -32,23 +76,46 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
-	minioConfig, err := loadMinioConfig()
+	storageConfig, err := loadStorageConfig()
 	if err != nil {
 		return nil, err
 	}
 
+	minioConfig := &MinioConfig{}
+	if storageConfig.Driver == StorageDriverMinio {
+		minioConfig, err = loadMinioConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	pgConfigAutoUpload, err := loadPostgresAutoUploadConfig()
 	if err != nil {
 		return nil, err
 	}
 	musicFolderPath, err := getEnv("MUSIC_FOLDER_PATH")
 	dancePhotosFolderPath, err := getEnv("DANCE_PHOTOS_FOLDER_PATH")
+	// Необязательные источники аудио: папка с файлами по fileUniqueId и Bot API
+	musicFileIdFolderPath := os.Getenv("MUSIC_FILE_ID_FOLDER_PATH")
+	authConfig, err := loadAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	cwebpPath := os.Getenv("CWEBP_PATH")
+	if cwebpPath == "" {
+		cwebpPath = "cwebp"
+	}
 
 	return &Config{
 		Postgres:              *pgConfig,
+		Storage:               storageConfig,
 		Minio:                 *minioConfig,
+		Telegram:              loadTelegramConfig(),
 		MusicFolderPath:       musicFolderPath,
+		MusicFileIdFolderPath: musicFileIdFolderPath,
 		DancePhotosFolderPath: dancePhotosFolderPath,
+		CWebPPath:             cwebpPath,
+		Auth:                  authConfig,
 		PostgresAutoUpload:    *pgConfigAutoUpload,
 	}, nil
 }
@@ -151,6 +218,18 @@ func loadMinioConfig() (*MinioConfig, error) {
 		return nil, err
 	}
 
+	var urlExpiry time.Duration
+	if value := os.Getenv("MINIO_URL_EXPIRY"); value != "" {
+		urlExpiry, err = time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MINIO_URL_EXPIRY: %w", err)
+		}
+		// S3 не принимает подписи короче секунды и дольше недели
+		if urlExpiry < time.Second || urlExpiry > 7*24*time.Hour {
+			return nil, fmt.Errorf("MINIO_URL_EXPIRY must be between 1s and 168h, got %s", urlExpiry)
+		}
+	}
+
 	return &MinioConfig{
 		Endpoint:  endpoint,
 		AccessKey: accessKey,
@@ -158,9 +237,82 @@ func loadMinioConfig() (*MinioConfig, error) {
 		Bucket:    bucket,
 		UseSSL:    useSSL,
 		ServerURL: serverURL,
+		URLExpiry: urlExpiry,
 	}, nil
 }
 
+func loadStorageConfig() (StorageConfig, error) {
+	cfg := StorageConfig{
+		Driver:         os.Getenv("STORAGE_DRIVER"),
+		LocalDir:       os.Getenv("LOCAL_STORAGE_DIR"),
+		LocalPublicURL: os.Getenv("LOCAL_STORAGE_PUBLIC_URL"),
+	}
+	if cfg.Driver == "" {
+		cfg.Driver = StorageDriverMinio
+	}
+	if cfg.LocalDir == "" {
+		cfg.LocalDir = "data/storage"
+	}
+	if cfg.LocalPublicURL == "" {
+		cfg.LocalPublicURL = "http://localhost:8080/files"
+	}
+
+	if cfg.Driver != StorageDriverMinio && cfg.Driver != StorageDriverLocal {
+		return StorageConfig{}, fmt.Errorf("unknown STORAGE_DRIVER %q, expected %s or %s", cfg.Driver, StorageDriverLocal, StorageDriverMinio)
+	}
+	return cfg, nil
+}
+
+func loadTelegramConfig() TelegramConfig {
+	apiURL := os.Getenv("TELEGRAM_API_URL")
+	if apiURL == "" {
+		apiURL = "https://api.telegram.org"
+	}
+
+	return TelegramConfig{
+		BotToken: os.Getenv("TELEGRAM_BOT_TOKEN"),
+		APIURL:   apiURL,
+	}
+}
+
+func loadAuthConfig() (AuthConfig, error) {
+	cfg := AuthConfig{
+		JWTAlgorithm: os.Getenv("AUTH_JWT_ALGORITHM"),
+		JWTIssuer:    os.Getenv("AUTH_JWT_ISSUER"),
+	}
+
+	key := os.Getenv("AUTH_JWT_KEY")
+	switch cfg.JWTAlgorithm {
+	case "":
+	case "HS256":
+		cfg.JWTKey = []byte(key)
+	case "RS256":
+		// Многострочный PEM неудобно класть в переменную окружения, поэтому для RS256 передаётся путь
+		pem, err := os.ReadFile(key)
+		if err != nil {
+			return AuthConfig{}, fmt.Errorf("read AUTH_JWT_KEY: %w", err)
+		}
+		cfg.JWTKey = pem
+	default:
+		return AuthConfig{}, fmt.Errorf("AUTH_JWT_ALGORITHM must be HS256 or RS256, got %q", cfg.JWTAlgorithm)
+	}
+
+	// AUTH_API_KEYS=имя:роль:ключ,имя:роль:ключ
+	for i, entry := range strings.Split(os.Getenv("AUTH_API_KEYS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			// Саму запись не выводим: в ней может быть ключ
+			return AuthConfig{}, fmt.Errorf("invalid AUTH_API_KEYS entry #%d, want name:role:key", i+1)
+		}
+		cfg.APIKeys = append(cfg.APIKeys, APIKeyConfig{Name: parts[0], Role: parts[1], Key: parts[2]})
+	}
+	return cfg, nil
+}
+
 func getEnv(key string) (string, error) {
 	val := os.Getenv(key)
 	if val == "" {
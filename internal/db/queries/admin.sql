@@ -0,0 +1,235 @@
+-- name: GetAdminDance :one
+SELECT d.id,
+       d.translation_id,
+       d.name,
+       d.complexity,
+       d.gender,
+       d.paces,
+       d.genres,
+       d.handshakes,
+       t.eng_name,
+       t.ru_name,
+       t.arm_name
+FROM dances d
+LEFT JOIN translations t ON d.translation_id = t.id
+WHERE d.id = $1
+  AND d.deleted_at IS NULL;
+
+-- name: LockDance :one
+SELECT translation_id
+FROM dances
+WHERE id = $1
+  AND deleted_at IS NULL
+    FOR UPDATE;
+
+-- name: GetDanceRegionIDs :many
+SELECT region_id
+FROM dance_region
+WHERE dance_id = $1
+ORDER BY region_id;
+
+-- name: GetExistingRegionIDs :many
+SELECT id
+FROM regions
+WHERE id = ANY (@ids::bigint[])
+  AND deleted_at IS NULL;
+
+-- name: CreateDance :one
+INSERT INTO dances (id, translation_id, name, complexity, gender, paces, genres, handshakes,
+                    media_status, created_in_admin)
+VALUES (nextval('dances_admin_id_seq'), $1, $2, $3, $4, $5, $6, $7, 'MISSING', TRUE)
+RETURNING id;
+
+-- name: UpdateDance :exec
+UPDATE dances
+SET translation_id    = $2,
+    name              = $3,
+    complexity        = $4,
+    gender            = $5,
+    paces             = $6,
+    genres            = $7,
+    handshakes        = $8,
+    modified_in_admin = TRUE,
+    updated_at        = NOW()
+WHERE id = $1;
+
+-- name: SoftDeleteDance :execrows
+UPDATE dances
+SET deleted_at        = NOW(),
+    modified_in_admin = TRUE,
+    updated_at        = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: DeleteDanceRegionsExcept :exec
+DELETE
+FROM dance_region
+WHERE dance_id = @dance_id
+  AND region_id <> ALL (@region_ids::bigint[]);
+
+-- name: GetExistingDanceIDs :many
+SELECT id
+FROM dances
+WHERE id = ANY (@ids::bigint[])
+  AND deleted_at IS NULL;
+
+-- name: GetExistingArtistIDs :many
+SELECT id
+FROM artists
+WHERE id = ANY (@ids::bigint[])
+  AND deleted_at IS NULL;
+
+-- name: GetAdminSong :one
+SELECT s.id,
+       s.translation_id,
+       s.name,
+       s.file_key,
+       s.media_status,
+       s.duration_ms,
+       s.bitrate_kbps,
+       s.sample_rate_hz,
+       s.tag_title,
+       s.tag_artist,
+       t.eng_name,
+       t.ru_name,
+       t.arm_name
+FROM songs s
+LEFT JOIN translations t ON s.translation_id = t.id
+WHERE s.id = $1
+  AND s.deleted_at IS NULL;
+
+-- name: LockSong :one
+SELECT translation_id, file_key
+FROM songs
+WHERE id = $1
+  AND deleted_at IS NULL
+    FOR UPDATE;
+
+-- name: GetSongDanceIDs :many
+SELECT dance_id
+FROM dance_song
+WHERE song_id = $1
+ORDER BY dance_id;
+
+-- name: GetSongArtistIDs :many
+SELECT artist_id
+FROM song_artist
+WHERE song_id = $1
+ORDER BY artist_id;
+
+-- name: CreateSong :one
+INSERT INTO songs (id, translation_id, name, file_key, media_status, created_in_admin)
+VALUES (nextval('songs_admin_id_seq'), $1, $2, '', 'MISSING', TRUE)
+RETURNING id;
+
+-- name: UpdateSong :exec
+UPDATE songs
+SET translation_id    = $2,
+    name              = $3,
+    modified_in_admin = TRUE,
+    updated_at        = NOW()
+WHERE id = $1;
+
+-- name: SetSongAudio :exec
+UPDATE songs
+SET file_key          = $2,
+    media_status      = 'OK',
+    duration_ms       = $3,
+    bitrate_kbps      = $4,
+    sample_rate_hz    = $5,
+    tag_title         = $6,
+    tag_artist        = $7,
+    modified_in_admin = TRUE,
+    updated_at        = NOW()
+WHERE id = $1;
+
+-- name: CountSongsByFileKey :one
+-- Сколько песен, включая удалённые, ссылаются на файл
+SELECT COUNT(*)
+FROM songs
+WHERE file_key = $1;
+
+-- name: SoftDeleteSong :execrows
+UPDATE songs
+SET deleted_at        = NOW(),
+    modified_in_admin = TRUE,
+    updated_at        = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: DeleteSongDancesExcept :exec
+DELETE
+FROM dance_song
+WHERE song_id = $1
+  AND dance_id <> ALL (@dance_ids::bigint[]);
+
+-- name: DeleteSongArtistsExcept :exec
+DELETE
+FROM song_artist
+WHERE song_id = $1
+  AND artist_id <> ALL (@artist_ids::bigint[]);
+
+-- name: GetAdminVideo :one
+SELECT v.id,
+       v.translation_id,
+       v.name,
+       v.link,
+       v.type,
+       t.eng_name,
+       t.ru_name,
+       t.arm_name
+FROM videos v
+LEFT JOIN translations t ON v.translation_id = t.id
+WHERE v.id = $1
+  AND v.deleted_at IS NULL;
+
+-- name: LockVideo :one
+SELECT translation_id
+FROM videos
+WHERE id = $1
+  AND deleted_at IS NULL
+    FOR UPDATE;
+
+-- name: LockVideoLinks :exec
+-- Уникальность ссылок проверяется в коде после нормализации, поэтому изменения видео идут по очереди
+SELECT pg_advisory_xact_lock(hashtext('videos.link'));
+
+-- name: GetActiveVideoLinks :many
+SELECT id, link
+FROM videos
+WHERE deleted_at IS NULL;
+
+-- name: GetVideoDanceIDs :many
+SELECT dance_id
+FROM dance_videos
+WHERE video_id = $1
+ORDER BY dance_id;
+
+-- name: CreateVideo :one
+INSERT INTO videos (link, translation_id, name, type, created_in_admin)
+VALUES ($1, $2, $3, $4, TRUE)
+RETURNING id;
+
+-- name: UpdateVideo :exec
+UPDATE videos
+SET link              = $2,
+    translation_id    = $3,
+    name              = $4,
+    type              = $5,
+    modified_in_admin = TRUE,
+    updated_at        = NOW()
+WHERE id = $1;
+
+-- name: SoftDeleteVideo :execrows
+UPDATE videos
+SET deleted_at        = NOW(),
+    modified_in_admin = TRUE,
+    updated_at        = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: DeleteVideoDancesExcept :exec
+DELETE
+FROM dance_videos
+WHERE video_id = $1
+  AND dance_id <> ALL (@dance_ids::bigint[]);
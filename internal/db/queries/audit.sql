@@ -0,0 +1,24 @@
+-- name: InsertAuditLog :exec
+-- InsertAuditLog пишет пачку записей журнала одного автора и одного запуска импорта
+INSERT INTO audit_log (actor, entity_type, entity_id, action, diff, import_run_id)
+SELECT sqlc.arg('actor')::varchar, e.entity_type, e.entity_id, e.action, e.diff::jsonb, sqlc.narg('import_run_id')::uuid
+FROM unnest(
+    sqlc.arg('entity_types')::varchar[],
+    sqlc.arg('entity_ids')::bigint[],
+    sqlc.arg('actions')::varchar[],
+    sqlc.arg('diffs')::text[]
+) AS e(entity_type, entity_id, action, diff);
+
+-- name: ListAuditLog :many
+SELECT id, created_at, actor, entity_type, entity_id, action, diff, import_run_id
+FROM audit_log
+WHERE (sqlc.narg('entity_type')::varchar IS NULL OR entity_type = sqlc.narg('entity_type')::varchar)
+  AND (sqlc.narg('entity_id')::bigint IS NULL OR entity_id = sqlc.narg('entity_id')::bigint)
+  AND (sqlc.narg('actor')::varchar IS NULL OR actor = sqlc.narg('actor')::varchar)
+  AND (sqlc.narg('action')::varchar IS NULL OR action = sqlc.narg('action')::varchar)
+  AND (sqlc.narg('import_run_id')::uuid IS NULL OR import_run_id = sqlc.narg('import_run_id')::uuid)
+  AND (sqlc.narg('from_time')::timestamptz IS NULL OR created_at >= sqlc.narg('from_time')::timestamptz)
+  AND (sqlc.narg('to_time')::timestamptz IS NULL OR created_at < sqlc.narg('to_time')::timestamptz)
+ORDER BY id DESC
+LIMIT  sqlc.arg('limit')::int
+    OFFSET sqlc.arg('offset')::int;
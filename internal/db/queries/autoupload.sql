@@ -9,6 +9,7 @@ TRUNCATE TABLE
     songs,
     dances,
     videos,
+    groups,
     translations
     RESTART IDENTITY CASCADE;
 
@@ -24,7 +25,7 @@ SELECT unnest(@eng_names::text[]) as eng_name,
     RETURNING id;
 
 -- name: GetRegions :many
-SELECT id, translation_id, name
+SELECT id, translation_id, name, deleted_at
 FROM regions;
 
 -- name: InsertRegions :exec
@@ -47,13 +48,18 @@ SELECT id,
        popularity,
        genres,
        handshakes,
-       deleted_at
+       deleted_at,
+       media_status,
+       created_in_admin,
+       photo_key,
+       photo_variants,
+       modified_in_admin
 FROM dances;
 
 -- name: InsertDance :exec
 INSERT INTO dances (id, translation_id, name, photo_key, complexity, gender,
-                    paces, popularity, genres, handshakes, deleted_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+                    paces, popularity, genres, handshakes, deleted_at, media_status, photo_variants)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
 
 -- name: GetDanceRegions :many
 SELECT dance_id, region_id
@@ -65,15 +71,25 @@ SELECT unnest(@dance_ids::bigint[])  as dance_id,
        unnest(@region_ids::bigint[]) as region_id ON CONFLICT (dance_id, region_id) DO NOTHING;
 
 -- name: GetSongs :many
-SELECT id, translation_id, name, file_key
+SELECT id, translation_id, name, file_key, lyrics_text, deleted_at, media_status, created_in_admin, duration_ms, bitrate_kbps,
+       modified_in_admin
 FROM songs;
 
 -- name: InsertSongs :exec
-INSERT INTO songs (id, translation_id, name, file_key)
-SELECT unnest(@ids::bigint[])             as id,
-       unnest(@translation_ids::bigint[]) as translation_id,
-       unnest(@names::text[])             as name,
-       unnest(@file_keys::text[])         as file_key;
+INSERT INTO songs (id, translation_id, name, file_key, lyrics_html, lyrics_text, media_status,
+                   duration_ms, bitrate_kbps, sample_rate_hz, tag_title, tag_artist)
+SELECT unnest(@ids::bigint[])                    as id,
+       unnest(@translation_ids::bigint[])        as translation_id,
+       unnest(@names::text[])                    as name,
+       unnest(@file_keys::text[])                as file_key,
+       unnest(@lyrics_htmls::text[])             as lyrics_html,
+       unnest(@lyrics_texts::text[])             as lyrics_text,
+       unnest(@media_statuses::text[])           as media_status,
+       NULLIF(unnest(@durations_ms::int[]), 0)   as duration_ms,
+       NULLIF(unnest(@bitrates_kbps::int[]), 0)  as bitrate_kbps,
+       NULLIF(unnest(@sample_rates_hz::int[]), 0) as sample_rate_hz,
+       unnest(@tag_titles::text[])               as tag_title,
+       unnest(@tag_artists::text[])              as tag_artist;
 
 -- name: GetDanceSongs :many
 SELECT dance_id, song_id
@@ -85,7 +101,7 @@ SELECT unnest(@dance_ids::bigint[]) as dance_id,
        unnest(@song_ids::bigint[])  as song_id ON CONFLICT (dance_id, song_id) DO NOTHING;
 
 -- name: GetVideos :many
-SELECT id, link, translation_id, name, type
+SELECT id, link, translation_id, name, type, deleted_at, created_in_admin, modified_in_admin
 FROM videos;
 
 -- name: InsertVideos :many
@@ -114,7 +130,252 @@ SELECT unnest(@ids::bigint[])              as id,
        unnest(@deleted_ats::timestamptz[]) as deleted_at;
 
 
+-- name: GetSongArtists :many
+SELECT song_id, artist_id
+FROM song_artist;
+
 -- name: InsertSongArtists :exec
 INSERT INTO song_artist (song_id, artist_id)
 SELECT unnest(@song_ids::bigint[])   as song_id,
-       unnest(@artist_ids::bigint[]) as artist_id ON CONFLICT (song_id, artist_id) DO NOTHING;
\ No newline at end of file
+       unnest(@artist_ids::bigint[]) as artist_id ON CONFLICT (song_id, artist_id) DO NOTHING;
+
+-- name: GetGroups :many
+SELECT id, translation_id, name, flag, country_code, link, type, deleted_at
+FROM groups;
+
+-- name: InsertGroups :exec
+INSERT INTO groups (translation_id, name, flag, country_code, link, type)
+SELECT unnest(@translation_ids::bigint[]) as translation_id,
+       unnest(@names::text[])             as name,
+       unnest(@flags::text[])             as flag,
+       unnest(@country_codes::text[])     as country_code,
+       unnest(@links::text[])             as link,
+       unnest(@types::text[])             as type;
+
+-- name: UpdateTranslations :exec
+UPDATE translations t
+SET eng_name   = s.eng_name,
+    ru_name    = s.ru_name,
+    arm_name   = s.arm_name,
+    updated_at = NOW()
+FROM (SELECT unnest(@ids::bigint[])     as id,
+             unnest(@eng_names::text[]) as eng_name,
+             unnest(@ru_names::text[])  as ru_name,
+             unnest(@arm_names::text[]) as arm_name) s
+WHERE t.id = s.id
+  AND (t.eng_name, t.ru_name, t.arm_name) IS DISTINCT FROM (s.eng_name, s.ru_name, s.arm_name);
+
+-- name: UpsertRegions :exec
+INSERT INTO regions (id, translation_id, name)
+SELECT unnest(@ids::bigint[])             as id,
+       unnest(@translation_ids::bigint[]) as translation_id,
+       unnest(@names::text[])             as name
+ON CONFLICT (id) DO UPDATE
+    SET translation_id = EXCLUDED.translation_id,
+        name           = EXCLUDED.name,
+        deleted_at     = NULL,
+        updated_at     = NOW()
+WHERE regions.deleted_at IS NOT NULL
+   OR (regions.translation_id, regions.name) IS DISTINCT FROM (EXCLUDED.translation_id, EXCLUDED.name);
+
+-- name: SoftDeleteMissingRegions :exec
+UPDATE regions
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND id <> ALL (@ids::bigint[]);
+
+-- name: UpsertArtists :exec
+INSERT INTO artists (id, translation_id, name, link, deleted_at)
+SELECT unnest(@ids::bigint[])              as id,
+       unnest(@translation_ids::bigint[])  as translation_id,
+       unnest(@names::text[])              as name,
+       unnest(@links::text[])              as link,
+       unnest(@deleted_ats::timestamptz[]) as deleted_at
+ON CONFLICT (id) DO UPDATE
+    SET translation_id = EXCLUDED.translation_id,
+        name           = EXCLUDED.name,
+        link           = EXCLUDED.link,
+        deleted_at     = CASE WHEN EXCLUDED.deleted_at IS NULL THEN NULL ELSE COALESCE(artists.deleted_at, EXCLUDED.deleted_at) END,
+        updated_at     = NOW()
+WHERE (artists.deleted_at IS NULL) <> (EXCLUDED.deleted_at IS NULL)
+   OR (artists.translation_id, artists.name, artists.link) IS DISTINCT FROM (EXCLUDED.translation_id, EXCLUDED.name, EXCLUDED.link);
+
+-- name: SoftDeleteMissingArtists :exec
+UPDATE artists
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND id <> ALL (@ids::bigint[]);
+
+-- name: UpsertDance :exec
+INSERT INTO dances (id, translation_id, name, photo_key, complexity, gender,
+                    paces, genres, handshakes, deleted_at, media_status, photo_variants)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (id) DO UPDATE
+    SET translation_id = EXCLUDED.translation_id,
+        name           = EXCLUDED.name,
+        photo_key      = EXCLUDED.photo_key,
+        complexity     = EXCLUDED.complexity,
+        gender         = EXCLUDED.gender,
+        paces          = EXCLUDED.paces,
+        genres         = EXCLUDED.genres,
+        handshakes     = EXCLUDED.handshakes,
+        media_status   = EXCLUDED.media_status,
+        photo_variants = EXCLUDED.photo_variants,
+        deleted_at     = CASE WHEN EXCLUDED.deleted_at IS NULL THEN NULL ELSE COALESCE(dances.deleted_at, EXCLUDED.deleted_at) END,
+        updated_at     = NOW()
+WHERE NOT dances.modified_in_admin
+  AND ((dances.deleted_at IS NULL) <> (EXCLUDED.deleted_at IS NULL)
+    OR (dances.translation_id, dances.name, dances.photo_key, dances.complexity, dances.gender,
+        dances.paces, dances.genres, dances.handshakes, dances.media_status, dances.photo_variants)
+     IS DISTINCT FROM
+       (EXCLUDED.translation_id, EXCLUDED.name, EXCLUDED.photo_key, EXCLUDED.complexity, EXCLUDED.gender,
+        EXCLUDED.paces, EXCLUDED.genres, EXCLUDED.handshakes, EXCLUDED.media_status, EXCLUDED.photo_variants));
+
+-- name: SoftDeleteMissingDances :exec
+UPDATE dances
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND NOT created_in_admin
+  AND NOT modified_in_admin
+  AND id <> ALL (@ids::bigint[]);
+
+-- name: UpsertSongs :exec
+INSERT INTO songs (id, translation_id, name, file_key, lyrics_html, lyrics_text, media_status,
+                   duration_ms, bitrate_kbps, sample_rate_hz, tag_title, tag_artist)
+SELECT unnest(@ids::bigint[])                    as id,
+       unnest(@translation_ids::bigint[])        as translation_id,
+       unnest(@names::text[])                    as name,
+       unnest(@file_keys::text[])                as file_key,
+       unnest(@lyrics_htmls::text[])             as lyrics_html,
+       unnest(@lyrics_texts::text[])             as lyrics_text,
+       unnest(@media_statuses::text[])           as media_status,
+       NULLIF(unnest(@durations_ms::int[]), 0)   as duration_ms,
+       NULLIF(unnest(@bitrates_kbps::int[]), 0)  as bitrate_kbps,
+       NULLIF(unnest(@sample_rates_hz::int[]), 0) as sample_rate_hz,
+       unnest(@tag_titles::text[])               as tag_title,
+       unnest(@tag_artists::text[])              as tag_artist
+ON CONFLICT (id) DO UPDATE
+    SET translation_id = EXCLUDED.translation_id,
+        name           = EXCLUDED.name,
+        file_key       = EXCLUDED.file_key,
+        lyrics_html    = EXCLUDED.lyrics_html,
+        lyrics_text    = EXCLUDED.lyrics_text,
+        media_status   = EXCLUDED.media_status,
+        duration_ms    = EXCLUDED.duration_ms,
+        bitrate_kbps   = EXCLUDED.bitrate_kbps,
+        sample_rate_hz = EXCLUDED.sample_rate_hz,
+        tag_title      = EXCLUDED.tag_title,
+        tag_artist     = EXCLUDED.tag_artist,
+        deleted_at     = NULL,
+        updated_at     = NOW()
+WHERE NOT songs.modified_in_admin
+  AND (songs.deleted_at IS NOT NULL
+    OR (songs.translation_id, songs.name, songs.file_key, songs.lyrics_html, songs.lyrics_text, songs.media_status,
+        songs.duration_ms, songs.bitrate_kbps, songs.sample_rate_hz, songs.tag_title, songs.tag_artist)
+     IS DISTINCT FROM
+       (EXCLUDED.translation_id, EXCLUDED.name, EXCLUDED.file_key, EXCLUDED.lyrics_html, EXCLUDED.lyrics_text, EXCLUDED.media_status,
+        EXCLUDED.duration_ms, EXCLUDED.bitrate_kbps, EXCLUDED.sample_rate_hz, EXCLUDED.tag_title, EXCLUDED.tag_artist));
+
+-- name: SoftDeleteMissingSongs :exec
+UPDATE songs
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND NOT created_in_admin
+  AND NOT modified_in_admin
+  AND id <> ALL (@ids::bigint[]);
+
+-- name: UpdateVideos :exec
+UPDATE videos v
+SET link           = s.link,
+    translation_id = s.translation_id,
+    name           = s.name,
+    type           = s.type,
+    deleted_at     = NULL,
+    updated_at     = NOW()
+FROM (SELECT unnest(@ids::bigint[])             as id,
+             unnest(@links::text[])             as link,
+             unnest(@translation_ids::bigint[]) as translation_id,
+             unnest(@names::text[])             as name,
+             unnest(@types::text[])             as type) s
+WHERE v.id = s.id
+  AND NOT v.modified_in_admin
+  AND (v.deleted_at IS NOT NULL
+    OR (v.link, v.translation_id, v.name, v.type) IS DISTINCT FROM (s.link, s.translation_id, s.name, s.type));
+
+-- name: SoftDeleteMissingVideos :exec
+UPDATE videos
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND NOT created_in_admin
+  AND NOT modified_in_admin
+  AND id <> ALL (@ids::bigint[]);
+
+-- name: UpdateGroups :exec
+UPDATE groups g
+SET translation_id = s.translation_id,
+    flag           = s.flag,
+    country_code   = s.country_code,
+    link           = s.link,
+    type           = s.type,
+    deleted_at     = NULL,
+    updated_at     = NOW()
+FROM (SELECT unnest(@ids::bigint[])             as id,
+             unnest(@translation_ids::bigint[]) as translation_id,
+             unnest(@flags::text[])             as flag,
+             unnest(@country_codes::text[])     as country_code,
+             unnest(@links::text[])             as link,
+             unnest(@types::text[])             as type) s
+WHERE g.id = s.id
+  AND (g.deleted_at IS NOT NULL
+    OR (g.translation_id, g.flag, g.country_code, g.link, g.type)
+     IS DISTINCT FROM (s.translation_id, s.flag, s.country_code, s.link, s.type));
+
+-- name: SoftDeleteMissingGroups :exec
+UPDATE groups
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND id <> ALL (@ids::bigint[]);
+
+-- name: DeleteStaleDanceRegions :exec
+DELETE
+FROM dance_region dr
+WHERE NOT EXISTS (SELECT 1
+                  FROM unnest(@dance_ids::bigint[], @region_ids::bigint[]) AS s(dance_id, region_id)
+                  WHERE s.dance_id = dr.dance_id
+                    AND s.region_id = dr.region_id)
+  AND NOT EXISTS (SELECT 1 FROM dances d WHERE d.id = dr.dance_id AND (d.created_in_admin OR d.modified_in_admin));
+
+-- name: DeleteStaleDanceSongs :exec
+DELETE
+FROM dance_song ds
+WHERE NOT EXISTS (SELECT 1
+                  FROM unnest(@dance_ids::bigint[], @song_ids::bigint[]) AS s(dance_id, song_id)
+                  WHERE s.dance_id = ds.dance_id
+                    AND s.song_id = ds.song_id)
+  AND NOT EXISTS (SELECT 1 FROM songs so WHERE so.id = ds.song_id AND (so.created_in_admin OR so.modified_in_admin))
+  AND NOT EXISTS (SELECT 1 FROM dances d WHERE d.id = ds.dance_id AND (d.created_in_admin OR d.modified_in_admin));
+
+-- name: DeleteStaleSongArtists :exec
+DELETE
+FROM song_artist sa
+WHERE NOT EXISTS (SELECT 1
+                  FROM unnest(@song_ids::bigint[], @artist_ids::bigint[]) AS s(song_id, artist_id)
+                  WHERE s.song_id = sa.song_id
+                    AND s.artist_id = sa.artist_id)
+  AND NOT EXISTS (SELECT 1 FROM songs so WHERE so.id = sa.song_id AND (so.created_in_admin OR so.modified_in_admin));
+
+-- name: DeleteStaleDanceVideos :exec
+DELETE
+FROM dance_videos dv
+WHERE NOT EXISTS (SELECT 1
+                  FROM unnest(@dance_ids::bigint[], @video_ids::bigint[]) AS s(dance_id, video_id)
+                  WHERE s.dance_id = dv.dance_id
+                    AND s.video_id = dv.video_id)
+  AND NOT EXISTS (SELECT 1 FROM videos v WHERE v.id = dv.video_id AND (v.created_in_admin OR v.modified_in_admin))
+  AND NOT EXISTS (SELECT 1 FROM dances d WHERE d.id = dv.dance_id AND (d.created_in_admin OR d.modified_in_admin));
\ No newline at end of file
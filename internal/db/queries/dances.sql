@@ -1,5 +1,5 @@
 -- name: GetDanceByID :one
-SELECT d.id, d.complexity, d.photo_key, d.gender, d.paces, d.genres, d.handshakes,
+SELECT d.id, d.complexity, d.photo_key, d.photo_variants, d.gender, d.paces, d.genres, d.handshakes,
 COALESCE(CASE WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name ELSE d.name END, d.name)::text AS name
 FROM dances d
 LEFT JOIN translations t ON d.translation_id = t.id
@@ -20,7 +20,8 @@ SELECT
 FROM regions r
 LEFT JOIN translations t ON r.translation_id = t.id
 JOIN dance_region dr ON dr.region_id = r.id
-WHERE dr.dance_id = $1;
+WHERE dr.dance_id = $1
+  AND r.deleted_at IS NULL;
 
 -- name: GetVideosByDanceID :many
 SELECT 
@@ -39,7 +40,8 @@ SELECT
 FROM videos v 
 LEFT JOIN translations t ON v.translation_id = t.id
 JOIN dance_videos dv ON dv.video_id = v.id 
-WHERE dv.dance_id = $1;
+WHERE dv.dance_id = $1
+  AND v.deleted_at IS NULL;
 
 -- name: GetSongsByDanceID :many
 SELECT 
@@ -53,11 +55,20 @@ SELECT
         END, 
         s.name
     )::text AS name,
-    s.file_key 
+    s.file_key,
+    s.lyrics_html,
+    s.lyrics_text,
+    s.media_status,
+    s.duration_ms,
+    s.bitrate_kbps,
+    s.sample_rate_hz,
+    s.tag_title,
+    s.tag_artist
 FROM songs s 
 LEFT JOIN translations t ON s.translation_id = t.id
 JOIN dance_song ds ON ds.song_id = s.id 
-WHERE ds.dance_id = $1;
+WHERE ds.dance_id = $1
+  AND s.deleted_at IS NULL;
 
 
 
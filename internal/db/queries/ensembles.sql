@@ -0,0 +1,82 @@
+-- name: ListEnsembles :many
+SELECT
+    a.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE a.name
+        END,
+        a.name
+    )::text AS name,
+    a.link,
+    a.deleted_at
+FROM artists a
+LEFT JOIN translations t ON a.translation_id = t.id
+WHERE sqlc.arg(include_deleted)::boolean OR a.deleted_at IS NULL
+ORDER BY a.id;
+
+-- name: GetEnsembleByID :one
+SELECT
+    a.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE a.name
+        END,
+        a.name
+    )::text AS name,
+    a.link,
+    a.deleted_at
+FROM artists a
+LEFT JOIN translations t ON a.translation_id = t.id
+WHERE a.id = $1
+  AND (sqlc.arg(include_deleted)::boolean OR a.deleted_at IS NULL);
+
+-- name: GetSongsByArtistIDs :many
+SELECT
+    sa.artist_id,
+    s.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE s.name
+        END,
+        s.name
+    )::text AS name,
+    s.file_key,
+    s.media_status
+FROM song_artist sa
+JOIN songs s ON s.id = sa.song_id
+LEFT JOIN translations t ON s.translation_id = t.id
+WHERE sa.artist_id = ANY(sqlc.arg(artist_ids)::bigint[])
+  AND s.deleted_at IS NULL
+ORDER BY sa.artist_id, s.id;
+
+-- name: GetDancesByArtistIDs :many
+SELECT DISTINCT
+    sa.artist_id,
+    d.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE d.name
+        END,
+        d.name
+    )::text AS name
+FROM song_artist sa
+JOIN songs s ON s.id = sa.song_id
+JOIN dance_song ds ON ds.song_id = sa.song_id
+JOIN dances d ON d.id = ds.dance_id
+LEFT JOIN translations t ON d.translation_id = t.id
+WHERE sa.artist_id = ANY(sqlc.arg(artist_ids)::bigint[])
+  AND s.deleted_at IS NULL
+  AND d.deleted_at IS NULL
+ORDER BY sa.artist_id, d.id;
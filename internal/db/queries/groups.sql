@@ -0,0 +1,22 @@
+-- name: ListGroups :many
+SELECT
+    g.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE g.name
+        END,
+        g.name
+    )::text AS name,
+    g.flag,
+    g.country_code,
+    g.link,
+    g.type
+FROM groups g
+LEFT JOIN translations t ON g.translation_id = t.id
+WHERE g.deleted_at IS NULL
+  AND (sqlc.narg('type')::text IS NULL OR g.type = sqlc.narg('type')::text)
+  AND (sqlc.narg('country_code')::text IS NULL OR g.country_code = UPPER(sqlc.narg('country_code')::text))
+ORDER BY g.id;
@@ -13,6 +13,7 @@ SELECT
     ) AS name,
     d.complexity,
     d.photo_key         AS photo_link,
+    d.photo_variants,
     d.gender,
     d.paces,
     d.genres,
@@ -108,6 +109,7 @@ GROUP BY
     ),
     d.complexity,
     d.photo_key,
+    d.photo_variants,
     d.gender,
     d.paces,
     d.genres,
@@ -0,0 +1,15 @@
+-- name: GetReferencedFileKeys :many
+-- Ключи файлов хранилища, на которые ссылаются записи, включая скрытые: их может вернуть следующий импорт
+SELECT photo_key::text AS file_key
+FROM dances
+WHERE photo_key IS NOT NULL
+  AND photo_key <> ''
+UNION
+SELECT v.value ->> 'key'
+FROM dances,
+     jsonb_array_elements(dances.photo_variants) AS v
+WHERE v.value ->> 'key' <> ''
+UNION
+SELECT file_key
+FROM songs
+WHERE file_key <> '';
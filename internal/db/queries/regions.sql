@@ -12,4 +12,5 @@ SELECT
     )::text AS name
 FROM regions r
 LEFT JOIN translations t ON r.translation_id = t.id
+WHERE r.deleted_at IS NULL
 ORDER BY r.id;
\ No newline at end of file
@@ -0,0 +1,114 @@
+-- name: LockDancesForRevision :exec
+-- LockDancesForRevision блокирует танцы, включая удалённые, до записи их версий. Порядок по id,
+-- чтобы правки с пересекающимися наборами танцев не ждали друг друга по кругу
+SELECT id
+FROM dances
+WHERE id = ANY (@ids::bigint[])
+ORDER BY id
+    FOR UPDATE;
+
+-- name: InsertDanceRevisions :exec
+-- InsertDanceRevisions снимает текущее состояние танцев. Снимок, не отличающийся от последней версии, не пишется.
+-- Номер версии считается от MAX(rev), поэтому танцы сначала блокируются LockDancesForRevision
+WITH snapshot AS (
+    SELECT d.id AS dance_id,
+           COALESCE((SELECT MAX(r.rev) FROM dance_revisions r WHERE r.dance_id = d.id), 0) + 1 AS rev,
+           d.name,
+           t.eng_name,
+           t.ru_name,
+           t.arm_name,
+           d.complexity,
+           d.gender,
+           COALESCE(d.paces, '{}')      AS paces,
+           COALESCE(d.genres, '{}')     AS genres,
+           COALESCE(d.handshakes, '{}') AS handshakes,
+           d.deleted_at IS NOT NULL     AS deleted,
+           ARRAY(SELECT dr.region_id FROM dance_region dr WHERE dr.dance_id = d.id ORDER BY dr.region_id)::bigint[] AS region_ids,
+           ARRAY(SELECT ds.song_id FROM dance_song ds WHERE ds.dance_id = d.id ORDER BY ds.song_id)::bigint[]       AS song_ids,
+           ARRAY(SELECT dv.video_id FROM dance_videos dv WHERE dv.dance_id = d.id ORDER BY dv.video_id)::bigint[]   AS video_ids
+    FROM dances d
+    LEFT JOIN translations t ON d.translation_id = t.id
+    WHERE d.id = ANY (@dance_ids::bigint[])
+)
+INSERT INTO dance_revisions (dance_id, rev, actor, import_run_id, name, eng_name, ru_name, arm_name, complexity,
+                             gender, paces, genres, handshakes, deleted, region_ids, song_ids, video_ids)
+SELECT s.dance_id, s.rev, sqlc.narg('actor')::varchar, sqlc.narg('import_run_id')::uuid, s.name, s.eng_name, s.ru_name,
+       s.arm_name, s.complexity, s.gender, s.paces, s.genres, s.handshakes, s.deleted, s.region_ids, s.song_ids, s.video_ids
+FROM snapshot s
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM dance_revisions r
+    WHERE r.dance_id = s.dance_id
+      AND r.rev = s.rev - 1
+      AND (r.name, r.eng_name, r.ru_name, r.arm_name, r.complexity, r.gender, r.paces, r.genres, r.handshakes,
+           r.deleted, r.region_ids, r.song_ids, r.video_ids)
+        IS NOT DISTINCT FROM
+          (s.name, s.eng_name, s.ru_name, s.arm_name, s.complexity, s.gender, s.paces, s.genres, s.handshakes,
+           s.deleted, s.region_ids, s.song_ids, s.video_ids)
+);
+
+-- name: ListDanceRevisions :many
+SELECT id, dance_id, rev, created_at, actor, import_run_id, name, eng_name, ru_name, arm_name, complexity, gender,
+       paces, genres, handshakes, deleted, region_ids, song_ids, video_ids
+FROM dance_revisions
+WHERE dance_id = $1
+ORDER BY rev DESC;
+
+-- name: GetDanceRevision :one
+SELECT id, dance_id, rev, created_at, actor, import_run_id, name, eng_name, ru_name, arm_name, complexity, gender,
+       paces, genres, handshakes, deleted, region_ids, song_ids, video_ids
+FROM dance_revisions
+WHERE dance_id = $1
+  AND rev = $2;
+
+-- name: LockDanceForRestore :one
+-- LockDanceForRestore, в отличие от LockDance, находит и удалённый танец: его тоже можно вернуть
+SELECT translation_id
+FROM dances
+WHERE id = $1
+    FOR UPDATE;
+
+-- name: DanceExists :one
+SELECT EXISTS (SELECT 1 FROM dances WHERE id = $1);
+
+-- name: RestoreDance :exec
+UPDATE dances
+SET translation_id    = @translation_id,
+    name              = @name,
+    complexity        = @complexity,
+    gender            = @gender,
+    paces             = @paces,
+    genres            = @genres,
+    handshakes        = @handshakes,
+    deleted_at        = CASE WHEN @deleted::boolean THEN COALESCE(deleted_at, NOW()) END,
+    modified_in_admin = TRUE,
+    updated_at        = NOW()
+WHERE id = @id;
+
+-- name: FilterRegionIDs :many
+-- FilterRegionIDs и соседние запросы оставляют id, строки которых есть в базе, в том числе удалённые
+SELECT id
+FROM regions
+WHERE id = ANY (@ids::bigint[]);
+
+-- name: FilterSongIDs :many
+SELECT id
+FROM songs
+WHERE id = ANY (@ids::bigint[]);
+
+-- name: FilterVideoIDs :many
+SELECT id
+FROM videos
+WHERE id = ANY (@ids::bigint[]);
+
+-- name: DeleteDanceSongsExcept :exec
+DELETE
+FROM dance_song
+WHERE dance_id = @dance_id
+  AND song_id <> ALL (@song_ids::bigint[]);
+
+-- name: DeleteDanceVideosExcept :exec
+DELETE
+FROM dance_videos
+WHERE dance_id = @dance_id
+  AND video_id <> ALL (@video_ids::bigint[]);
@@ -0,0 +1,115 @@
+-- name: ListSongs :many
+SELECT
+    s.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE s.name
+        END,
+        s.name
+    )::text AS name,
+    s.file_key,
+    s.media_status,
+    s.duration_ms,
+    s.bitrate_kbps,
+    s.sample_rate_hz,
+    s.tag_title,
+    s.tag_artist
+FROM songs s
+LEFT JOIN translations t ON s.translation_id = t.id
+WHERE s.deleted_at IS NULL
+  AND (
+    sqlc.narg('dance_id')::bigint IS NULL
+        OR EXISTS (SELECT 1 FROM dance_song ds WHERE ds.song_id = s.id AND ds.dance_id = sqlc.narg('dance_id')::bigint)
+    )
+  AND (
+    sqlc.narg('artist_id')::bigint IS NULL
+        OR EXISTS (SELECT 1 FROM song_artist sa WHERE sa.song_id = s.id AND sa.artist_id = sqlc.narg('artist_id')::bigint)
+    )
+  AND (
+    CASE
+        WHEN sqlc.arg(search_text)::text <> ''
+            THEN CONCAT_WS(' ', t.eng_name, t.ru_name, t.arm_name, s.name) ILIKE ('%' || sqlc.arg(search_text)::text || '%')
+        ELSE TRUE
+        END
+    )
+ORDER BY s.id
+LIMIT  sqlc.arg('limit')::int
+    OFFSET sqlc.arg('offset')::int;
+
+-- name: GetSongByID :one
+SELECT
+    s.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE s.name
+        END,
+        s.name
+    )::text AS name,
+    s.file_key,
+    s.media_status,
+    s.duration_ms,
+    s.bitrate_kbps,
+    s.sample_rate_hz,
+    s.tag_title,
+    s.tag_artist
+FROM songs s
+LEFT JOIN translations t ON s.translation_id = t.id
+WHERE s.id = $1
+  AND s.deleted_at IS NULL;
+
+-- name: GetDancesBySongIDs :many
+SELECT
+    ds.song_id,
+    d.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE d.name
+        END,
+        d.name
+    )::text AS name
+FROM dance_song ds
+JOIN dances d ON d.id = ds.dance_id
+LEFT JOIN translations t ON d.translation_id = t.id
+WHERE ds.song_id = ANY(sqlc.arg(song_ids)::bigint[])
+  AND d.deleted_at IS NULL
+ORDER BY ds.song_id, d.id;
+
+-- name: GetEnsemblesBySongIDs :many
+SELECT
+    sa.song_id,
+    a.id,
+    COALESCE(
+        CASE
+            WHEN sqlc.narg('lang')::text = 'ru' THEN t.ru_name
+            WHEN sqlc.narg('lang')::text = 'en' THEN t.eng_name
+            WHEN sqlc.narg('lang')::text = 'hy' THEN t.arm_name
+            ELSE a.name
+        END,
+        a.name
+    )::text AS name,
+    a.link
+FROM song_artist sa
+JOIN artists a ON a.id = sa.artist_id
+LEFT JOIN translations t ON a.translation_id = t.id
+WHERE sa.song_id = ANY(sqlc.arg(song_ids)::bigint[])
+ORDER BY sa.song_id, a.id;
+
+-- name: GetSongLyrics :one
+SELECT id, lyrics_html, lyrics_text
+FROM songs
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: IncrementSongPlayCount :exec
+UPDATE songs
+SET play_count = play_count + 1
+WHERE id = $1;
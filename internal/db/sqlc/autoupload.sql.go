@@ -11,6 +11,88 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deleteStaleDanceRegions = `-- name: DeleteStaleDanceRegions :exec
+DELETE
+FROM dance_region dr
+WHERE NOT EXISTS (SELECT 1
+                  FROM unnest($1::bigint[], $2::bigint[]) AS s(dance_id, region_id)
+                  WHERE s.dance_id = dr.dance_id
+                    AND s.region_id = dr.region_id)
+  AND NOT EXISTS (SELECT 1 FROM dances d WHERE d.id = dr.dance_id AND (d.created_in_admin OR d.modified_in_admin))
+`
+
+type DeleteStaleDanceRegionsParams struct {
+	DanceIds  []int64 `json:"dance_ids"`
+	RegionIds []int64 `json:"region_ids"`
+}
+
+func (q *Queries) DeleteStaleDanceRegions(ctx context.Context, arg DeleteStaleDanceRegionsParams) error {
+	_, err := q.db.Exec(ctx, deleteStaleDanceRegions, arg.DanceIds, arg.RegionIds)
+	return err
+}
+
+const deleteStaleDanceSongs = `-- name: DeleteStaleDanceSongs :exec
+DELETE
+FROM dance_song ds
+WHERE NOT EXISTS (SELECT 1
+                  FROM unnest($1::bigint[], $2::bigint[]) AS s(dance_id, song_id)
+                  WHERE s.dance_id = ds.dance_id
+                    AND s.song_id = ds.song_id)
+  AND NOT EXISTS (SELECT 1 FROM songs so WHERE so.id = ds.song_id AND (so.created_in_admin OR so.modified_in_admin))
+  AND NOT EXISTS (SELECT 1 FROM dances d WHERE d.id = ds.dance_id AND (d.created_in_admin OR d.modified_in_admin))
+`
+
+type DeleteStaleDanceSongsParams struct {
+	DanceIds []int64 `json:"dance_ids"`
+	SongIds  []int64 `json:"song_ids"`
+}
+
+func (q *Queries) DeleteStaleDanceSongs(ctx context.Context, arg DeleteStaleDanceSongsParams) error {
+	_, err := q.db.Exec(ctx, deleteStaleDanceSongs, arg.DanceIds, arg.SongIds)
+	return err
+}
+
+const deleteStaleDanceVideos = `-- name: DeleteStaleDanceVideos :exec
+DELETE
+FROM dance_videos dv
+WHERE NOT EXISTS (SELECT 1
+                  FROM unnest($1::bigint[], $2::bigint[]) AS s(dance_id, video_id)
+                  WHERE s.dance_id = dv.dance_id
+                    AND s.video_id = dv.video_id)
+  AND NOT EXISTS (SELECT 1 FROM videos v WHERE v.id = dv.video_id AND (v.created_in_admin OR v.modified_in_admin))
+  AND NOT EXISTS (SELECT 1 FROM dances d WHERE d.id = dv.dance_id AND (d.created_in_admin OR d.modified_in_admin))
+`
+
+type DeleteStaleDanceVideosParams struct {
+	DanceIds []int64 `json:"dance_ids"`
+	VideoIds []int64 `json:"video_ids"`
+}
+
+func (q *Queries) DeleteStaleDanceVideos(ctx context.Context, arg DeleteStaleDanceVideosParams) error {
+	_, err := q.db.Exec(ctx, deleteStaleDanceVideos, arg.DanceIds, arg.VideoIds)
+	return err
+}
+
+const deleteStaleSongArtists = `-- name: DeleteStaleSongArtists :exec
+DELETE
+FROM song_artist sa
+WHERE NOT EXISTS (SELECT 1
+                  FROM unnest($1::bigint[], $2::bigint[]) AS s(song_id, artist_id)
+                  WHERE s.song_id = sa.song_id
+                    AND s.artist_id = sa.artist_id)
+  AND NOT EXISTS (SELECT 1 FROM songs so WHERE so.id = sa.song_id AND (so.created_in_admin OR so.modified_in_admin))
+`
+
+type DeleteStaleSongArtistsParams struct {
+	SongIds   []int64 `json:"song_ids"`
+	ArtistIds []int64 `json:"artist_ids"`
+}
+
+func (q *Queries) DeleteStaleSongArtists(ctx context.Context, arg DeleteStaleSongArtistsParams) error {
+	_, err := q.db.Exec(ctx, deleteStaleSongArtists, arg.SongIds, arg.ArtistIds)
+	return err
+}
+
 const getArtists = `-- name: GetArtists :many
 SELECT id, translation_id, name, link, deleted_at
 FROM artists
@@ -150,21 +232,31 @@ SELECT id,
        popularity,
        genres,
        handshakes,
-       deleted_at
+       deleted_at,
+       media_status,
+       created_in_admin,
+       photo_key,
+       photo_variants,
+       modified_in_admin
 FROM dances
 `
 
 type GetDancesRow struct {
-	ID            int64              `json:"id"`
-	TranslationID pgtype.Int8        `json:"translation_id"`
-	Name          string             `json:"name"`
-	Complexity    pgtype.Int4        `json:"complexity"`
-	Gender        string             `json:"gender"`
-	Paces         []int32            `json:"paces"`
-	Popularity    int32              `json:"popularity"`
-	Genres        []string           `json:"genres"`
-	Handshakes    []string           `json:"handshakes"`
-	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+	ID              int64              `json:"id"`
+	TranslationID   pgtype.Int8        `json:"translation_id"`
+	Name            string             `json:"name"`
+	Complexity      pgtype.Int4        `json:"complexity"`
+	Gender          string             `json:"gender"`
+	Paces           []int32            `json:"paces"`
+	Popularity      int32              `json:"popularity"`
+	Genres          []string           `json:"genres"`
+	Handshakes      []string           `json:"handshakes"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	MediaStatus     string             `json:"media_status"`
+	CreatedInAdmin  bool               `json:"created_in_admin"`
+	PhotoKey        pgtype.Text        `json:"photo_key"`
+	PhotoVariants   []byte             `json:"photo_variants"`
+	ModifiedInAdmin bool               `json:"modified_in_admin"`
 }
 
 func (q *Queries) GetDances(ctx context.Context) ([]GetDancesRow, error) {
@@ -187,6 +279,56 @@ func (q *Queries) GetDances(ctx context.Context) ([]GetDancesRow, error) {
 			&i.Genres,
 			&i.Handshakes,
 			&i.DeletedAt,
+			&i.MediaStatus,
+			&i.CreatedInAdmin,
+			&i.PhotoKey,
+			&i.PhotoVariants,
+			&i.ModifiedInAdmin,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGroups = `-- name: GetGroups :many
+SELECT id, translation_id, name, flag, country_code, link, type, deleted_at
+FROM groups
+`
+
+type GetGroupsRow struct {
+	ID            int64              `json:"id"`
+	TranslationID pgtype.Int8        `json:"translation_id"`
+	Name          string             `json:"name"`
+	Flag          string             `json:"flag"`
+	CountryCode   string             `json:"country_code"`
+	Link          string             `json:"link"`
+	Type          string             `json:"type"`
+	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+}
+
+func (q *Queries) GetGroups(ctx context.Context) ([]GetGroupsRow, error) {
+	rows, err := q.db.Query(ctx, getGroups)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetGroupsRow{}
+	for rows.Next() {
+		var i GetGroupsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TranslationID,
+			&i.Name,
+			&i.Flag,
+			&i.CountryCode,
+			&i.Link,
+			&i.Type,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -199,14 +341,15 @@ func (q *Queries) GetDances(ctx context.Context) ([]GetDancesRow, error) {
 }
 
 const getRegions = `-- name: GetRegions :many
-SELECT id, translation_id, name
+SELECT id, translation_id, name, deleted_at
 FROM regions
 `
 
 type GetRegionsRow struct {
-	ID            int64       `json:"id"`
-	TranslationID pgtype.Int8 `json:"translation_id"`
-	Name          string      `json:"name"`
+	ID            int64              `json:"id"`
+	TranslationID pgtype.Int8        `json:"translation_id"`
+	Name          string             `json:"name"`
+	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
 }
 
 func (q *Queries) GetRegions(ctx context.Context) ([]GetRegionsRow, error) {
@@ -218,7 +361,42 @@ func (q *Queries) GetRegions(ctx context.Context) ([]GetRegionsRow, error) {
 	items := []GetRegionsRow{}
 	for rows.Next() {
 		var i GetRegionsRow
-		if err := rows.Scan(&i.ID, &i.TranslationID, &i.Name); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.TranslationID,
+			&i.Name,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSongArtists = `-- name: GetSongArtists :many
+SELECT song_id, artist_id
+FROM song_artist
+`
+
+type GetSongArtistsRow struct {
+	SongID   int64 `json:"song_id"`
+	ArtistID int64 `json:"artist_id"`
+}
+
+func (q *Queries) GetSongArtists(ctx context.Context) ([]GetSongArtistsRow, error) {
+	rows, err := q.db.Query(ctx, getSongArtists)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSongArtistsRow{}
+	for rows.Next() {
+		var i GetSongArtistsRow
+		if err := rows.Scan(&i.SongID, &i.ArtistID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -230,15 +408,23 @@ func (q *Queries) GetRegions(ctx context.Context) ([]GetRegionsRow, error) {
 }
 
 const getSongs = `-- name: GetSongs :many
-SELECT id, translation_id, name, file_key
+SELECT id, translation_id, name, file_key, lyrics_text, deleted_at, media_status, created_in_admin, duration_ms, bitrate_kbps,
+       modified_in_admin
 FROM songs
 `
 
 type GetSongsRow struct {
-	ID            int64       `json:"id"`
-	TranslationID pgtype.Int8 `json:"translation_id"`
-	Name          string      `json:"name"`
-	FileKey       string      `json:"file_key"`
+	ID              int64              `json:"id"`
+	TranslationID   pgtype.Int8        `json:"translation_id"`
+	Name            string             `json:"name"`
+	FileKey         string             `json:"file_key"`
+	LyricsText      string             `json:"lyrics_text"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	MediaStatus     string             `json:"media_status"`
+	CreatedInAdmin  bool               `json:"created_in_admin"`
+	DurationMs      pgtype.Int4        `json:"duration_ms"`
+	BitrateKbps     pgtype.Int4        `json:"bitrate_kbps"`
+	ModifiedInAdmin bool               `json:"modified_in_admin"`
 }
 
 func (q *Queries) GetSongs(ctx context.Context) ([]GetSongsRow, error) {
@@ -255,6 +441,13 @@ func (q *Queries) GetSongs(ctx context.Context) ([]GetSongsRow, error) {
 			&i.TranslationID,
 			&i.Name,
 			&i.FileKey,
+			&i.LyricsText,
+			&i.DeletedAt,
+			&i.MediaStatus,
+			&i.CreatedInAdmin,
+			&i.DurationMs,
+			&i.BitrateKbps,
+			&i.ModifiedInAdmin,
 		); err != nil {
 			return nil, err
 		}
@@ -304,16 +497,19 @@ func (q *Queries) GetTranslations(ctx context.Context) ([]GetTranslationsRow, er
 }
 
 const getVideos = `-- name: GetVideos :many
-SELECT id, link, translation_id, name, type
+SELECT id, link, translation_id, name, type, deleted_at, created_in_admin, modified_in_admin
 FROM videos
 `
 
 type GetVideosRow struct {
-	ID            int64       `json:"id"`
-	Link          string      `json:"link"`
-	TranslationID pgtype.Int8 `json:"translation_id"`
-	Name          string      `json:"name"`
-	Type          string      `json:"type"`
+	ID              int64              `json:"id"`
+	Link            string             `json:"link"`
+	TranslationID   pgtype.Int8        `json:"translation_id"`
+	Name            string             `json:"name"`
+	Type            string             `json:"type"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	CreatedInAdmin  bool               `json:"created_in_admin"`
+	ModifiedInAdmin bool               `json:"modified_in_admin"`
 }
 
 func (q *Queries) GetVideos(ctx context.Context) ([]GetVideosRow, error) {
@@ -331,6 +527,9 @@ func (q *Queries) GetVideos(ctx context.Context) ([]GetVideosRow, error) {
 			&i.TranslationID,
 			&i.Name,
 			&i.Type,
+			&i.DeletedAt,
+			&i.CreatedInAdmin,
+			&i.ModifiedInAdmin,
 		); err != nil {
 			return nil, err
 		}
@@ -372,8 +571,8 @@ func (q *Queries) InsertArtists(ctx context.Context, arg InsertArtistsParams) er
 
 const insertDance = `-- name: InsertDance :exec
 INSERT INTO dances (id, translation_id, name, photo_key, complexity, gender,
-                    paces, popularity, genres, handshakes, deleted_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+                    paces, popularity, genres, handshakes, deleted_at, media_status, photo_variants)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 `
 
 type InsertDanceParams struct {
@@ -388,6 +587,8 @@ type InsertDanceParams struct {
 	Genres        []string           `json:"genres"`
 	Handshakes    []string           `json:"handshakes"`
 	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+	MediaStatus   string             `json:"media_status"`
+	PhotoVariants []byte             `json:"photo_variants"`
 }
 
 func (q *Queries) InsertDance(ctx context.Context, arg InsertDanceParams) error {
@@ -403,6 +604,8 @@ func (q *Queries) InsertDance(ctx context.Context, arg InsertDanceParams) error
 		arg.Genres,
 		arg.Handshakes,
 		arg.DeletedAt,
+		arg.MediaStatus,
+		arg.PhotoVariants,
 	)
 	return err
 }
@@ -455,6 +658,37 @@ func (q *Queries) InsertDanceVideos(ctx context.Context, arg InsertDanceVideosPa
 	return err
 }
 
+const insertGroups = `-- name: InsertGroups :exec
+INSERT INTO groups (translation_id, name, flag, country_code, link, type)
+SELECT unnest($1::bigint[]) as translation_id,
+       unnest($2::text[])             as name,
+       unnest($3::text[])             as flag,
+       unnest($4::text[])     as country_code,
+       unnest($5::text[])             as link,
+       unnest($6::text[])             as type
+`
+
+type InsertGroupsParams struct {
+	TranslationIds []int64  `json:"translation_ids"`
+	Names          []string `json:"names"`
+	Flags          []string `json:"flags"`
+	CountryCodes   []string `json:"country_codes"`
+	Links          []string `json:"links"`
+	Types          []string `json:"types"`
+}
+
+func (q *Queries) InsertGroups(ctx context.Context, arg InsertGroupsParams) error {
+	_, err := q.db.Exec(ctx, insertGroups,
+		arg.TranslationIds,
+		arg.Names,
+		arg.Flags,
+		arg.CountryCodes,
+		arg.Links,
+		arg.Types,
+	)
+	return err
+}
+
 const insertRegions = `-- name: InsertRegions :exec
 INSERT INTO regions (id, translation_id, name)
 SELECT unnest($1::bigint[])             as id,
@@ -490,11 +724,20 @@ func (q *Queries) InsertSongArtists(ctx context.Context, arg InsertSongArtistsPa
 }
 
 const insertSongs = `-- name: InsertSongs :exec
-INSERT INTO songs (id, translation_id, name, file_key)
-SELECT unnest($1::bigint[])             as id,
-       unnest($2::bigint[]) as translation_id,
-       unnest($3::text[])             as name,
-       unnest($4::text[])         as file_key
+INSERT INTO songs (id, translation_id, name, file_key, lyrics_html, lyrics_text, media_status,
+                   duration_ms, bitrate_kbps, sample_rate_hz, tag_title, tag_artist)
+SELECT unnest($1::bigint[])                    as id,
+       unnest($2::bigint[])        as translation_id,
+       unnest($3::text[])                    as name,
+       unnest($4::text[])                as file_key,
+       unnest($5::text[])             as lyrics_html,
+       unnest($6::text[])             as lyrics_text,
+       unnest($7::text[])           as media_status,
+       NULLIF(unnest($8::int[]), 0)   as duration_ms,
+       NULLIF(unnest($9::int[]), 0)  as bitrate_kbps,
+       NULLIF(unnest($10::int[]), 0) as sample_rate_hz,
+       unnest($11::text[])               as tag_title,
+       unnest($12::text[])              as tag_artist
 `
 
 type InsertSongsParams struct {
@@ -502,6 +745,14 @@ type InsertSongsParams struct {
 	TranslationIds []int64  `json:"translation_ids"`
 	Names          []string `json:"names"`
 	FileKeys       []string `json:"file_keys"`
+	LyricsHtmls    []string `json:"lyrics_htmls"`
+	LyricsTexts    []string `json:"lyrics_texts"`
+	MediaStatuses  []string `json:"media_statuses"`
+	DurationsMs    []int32  `json:"durations_ms"`
+	BitratesKbps   []int32  `json:"bitrates_kbps"`
+	SampleRatesHz  []int32  `json:"sample_rates_hz"`
+	TagTitles      []string `json:"tag_titles"`
+	TagArtists     []string `json:"tag_artists"`
 }
 
 func (q *Queries) InsertSongs(ctx context.Context, arg InsertSongsParams) error {
@@ -510,6 +761,14 @@ func (q *Queries) InsertSongs(ctx context.Context, arg InsertSongsParams) error
 		arg.TranslationIds,
 		arg.Names,
 		arg.FileKeys,
+		arg.LyricsHtmls,
+		arg.LyricsTexts,
+		arg.MediaStatuses,
+		arg.DurationsMs,
+		arg.BitratesKbps,
+		arg.SampleRatesHz,
+		arg.TagTitles,
+		arg.TagArtists,
 	)
 	return err
 }
@@ -589,6 +848,90 @@ func (q *Queries) InsertVideos(ctx context.Context, arg InsertVideosParams) ([]i
 	return items, nil
 }
 
+const softDeleteMissingArtists = `-- name: SoftDeleteMissingArtists :exec
+UPDATE artists
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND id <> ALL ($1::bigint[])
+`
+
+func (q *Queries) SoftDeleteMissingArtists(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, softDeleteMissingArtists, ids)
+	return err
+}
+
+const softDeleteMissingDances = `-- name: SoftDeleteMissingDances :exec
+UPDATE dances
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND NOT created_in_admin
+  AND NOT modified_in_admin
+  AND id <> ALL ($1::bigint[])
+`
+
+func (q *Queries) SoftDeleteMissingDances(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, softDeleteMissingDances, ids)
+	return err
+}
+
+const softDeleteMissingGroups = `-- name: SoftDeleteMissingGroups :exec
+UPDATE groups
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND id <> ALL ($1::bigint[])
+`
+
+func (q *Queries) SoftDeleteMissingGroups(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, softDeleteMissingGroups, ids)
+	return err
+}
+
+const softDeleteMissingRegions = `-- name: SoftDeleteMissingRegions :exec
+UPDATE regions
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND id <> ALL ($1::bigint[])
+`
+
+func (q *Queries) SoftDeleteMissingRegions(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, softDeleteMissingRegions, ids)
+	return err
+}
+
+const softDeleteMissingSongs = `-- name: SoftDeleteMissingSongs :exec
+UPDATE songs
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND NOT created_in_admin
+  AND NOT modified_in_admin
+  AND id <> ALL ($1::bigint[])
+`
+
+func (q *Queries) SoftDeleteMissingSongs(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, softDeleteMissingSongs, ids)
+	return err
+}
+
+const softDeleteMissingVideos = `-- name: SoftDeleteMissingVideos :exec
+UPDATE videos
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE deleted_at IS NULL
+  AND NOT created_in_admin
+  AND NOT modified_in_admin
+  AND id <> ALL ($1::bigint[])
+`
+
+func (q *Queries) SoftDeleteMissingVideos(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, softDeleteMissingVideos, ids)
+	return err
+}
+
 const truncateAllTables = `-- name: TruncateAllTables :exec
 TRUNCATE TABLE
     dance_region,
@@ -600,6 +943,7 @@ TRUNCATE TABLE
     songs,
     dances,
     videos,
+    groups,
     translations
     RESTART IDENTITY CASCADE
 `
@@ -608,3 +952,305 @@ func (q *Queries) TruncateAllTables(ctx context.Context) error {
 	_, err := q.db.Exec(ctx, truncateAllTables)
 	return err
 }
+
+const updateGroups = `-- name: UpdateGroups :exec
+UPDATE groups g
+SET translation_id = s.translation_id,
+    flag           = s.flag,
+    country_code   = s.country_code,
+    link           = s.link,
+    type           = s.type,
+    deleted_at     = NULL,
+    updated_at     = NOW()
+FROM (SELECT unnest($1::bigint[])             as id,
+             unnest($2::bigint[]) as translation_id,
+             unnest($3::text[])             as flag,
+             unnest($4::text[])     as country_code,
+             unnest($5::text[])             as link,
+             unnest($6::text[])             as type) s
+WHERE g.id = s.id
+  AND (g.deleted_at IS NOT NULL
+    OR (g.translation_id, g.flag, g.country_code, g.link, g.type)
+     IS DISTINCT FROM (s.translation_id, s.flag, s.country_code, s.link, s.type))
+`
+
+type UpdateGroupsParams struct {
+	Ids            []int64  `json:"ids"`
+	TranslationIds []int64  `json:"translation_ids"`
+	Flags          []string `json:"flags"`
+	CountryCodes   []string `json:"country_codes"`
+	Links          []string `json:"links"`
+	Types          []string `json:"types"`
+}
+
+func (q *Queries) UpdateGroups(ctx context.Context, arg UpdateGroupsParams) error {
+	_, err := q.db.Exec(ctx, updateGroups,
+		arg.Ids,
+		arg.TranslationIds,
+		arg.Flags,
+		arg.CountryCodes,
+		arg.Links,
+		arg.Types,
+	)
+	return err
+}
+
+const updateTranslations = `-- name: UpdateTranslations :exec
+UPDATE translations t
+SET eng_name   = s.eng_name,
+    ru_name    = s.ru_name,
+    arm_name   = s.arm_name,
+    updated_at = NOW()
+FROM (SELECT unnest($1::bigint[])     as id,
+             unnest($2::text[]) as eng_name,
+             unnest($3::text[])  as ru_name,
+             unnest($4::text[]) as arm_name) s
+WHERE t.id = s.id
+  AND (t.eng_name, t.ru_name, t.arm_name) IS DISTINCT FROM (s.eng_name, s.ru_name, s.arm_name)
+`
+
+type UpdateTranslationsParams struct {
+	Ids      []int64  `json:"ids"`
+	EngNames []string `json:"eng_names"`
+	RuNames  []string `json:"ru_names"`
+	ArmNames []string `json:"arm_names"`
+}
+
+func (q *Queries) UpdateTranslations(ctx context.Context, arg UpdateTranslationsParams) error {
+	_, err := q.db.Exec(ctx, updateTranslations,
+		arg.Ids,
+		arg.EngNames,
+		arg.RuNames,
+		arg.ArmNames,
+	)
+	return err
+}
+
+const updateVideos = `-- name: UpdateVideos :exec
+UPDATE videos v
+SET link           = s.link,
+    translation_id = s.translation_id,
+    name           = s.name,
+    type           = s.type,
+    deleted_at     = NULL,
+    updated_at     = NOW()
+FROM (SELECT unnest($1::bigint[])             as id,
+             unnest($2::text[])             as link,
+             unnest($3::bigint[]) as translation_id,
+             unnest($4::text[])             as name,
+             unnest($5::text[])             as type) s
+WHERE v.id = s.id
+  AND NOT v.modified_in_admin
+  AND (v.deleted_at IS NOT NULL
+    OR (v.link, v.translation_id, v.name, v.type) IS DISTINCT FROM (s.link, s.translation_id, s.name, s.type))
+`
+
+type UpdateVideosParams struct {
+	Ids            []int64  `json:"ids"`
+	Links          []string `json:"links"`
+	TranslationIds []int64  `json:"translation_ids"`
+	Names          []string `json:"names"`
+	Types          []string `json:"types"`
+}
+
+func (q *Queries) UpdateVideos(ctx context.Context, arg UpdateVideosParams) error {
+	_, err := q.db.Exec(ctx, updateVideos,
+		arg.Ids,
+		arg.Links,
+		arg.TranslationIds,
+		arg.Names,
+		arg.Types,
+	)
+	return err
+}
+
+const upsertArtists = `-- name: UpsertArtists :exec
+INSERT INTO artists (id, translation_id, name, link, deleted_at)
+SELECT unnest($1::bigint[])              as id,
+       unnest($2::bigint[])  as translation_id,
+       unnest($3::text[])              as name,
+       unnest($4::text[])              as link,
+       unnest($5::timestamptz[]) as deleted_at
+ON CONFLICT (id) DO UPDATE
+    SET translation_id = EXCLUDED.translation_id,
+        name           = EXCLUDED.name,
+        link           = EXCLUDED.link,
+        deleted_at     = CASE WHEN EXCLUDED.deleted_at IS NULL THEN NULL ELSE COALESCE(artists.deleted_at, EXCLUDED.deleted_at) END,
+        updated_at     = NOW()
+WHERE (artists.deleted_at IS NULL) <> (EXCLUDED.deleted_at IS NULL)
+   OR (artists.translation_id, artists.name, artists.link) IS DISTINCT FROM (EXCLUDED.translation_id, EXCLUDED.name, EXCLUDED.link)
+`
+
+type UpsertArtistsParams struct {
+	Ids            []int64              `json:"ids"`
+	TranslationIds []int64              `json:"translation_ids"`
+	Names          []string             `json:"names"`
+	Links          []string             `json:"links"`
+	DeletedAts     []pgtype.Timestamptz `json:"deleted_ats"`
+}
+
+func (q *Queries) UpsertArtists(ctx context.Context, arg UpsertArtistsParams) error {
+	_, err := q.db.Exec(ctx, upsertArtists,
+		arg.Ids,
+		arg.TranslationIds,
+		arg.Names,
+		arg.Links,
+		arg.DeletedAts,
+	)
+	return err
+}
+
+const upsertDance = `-- name: UpsertDance :exec
+INSERT INTO dances (id, translation_id, name, photo_key, complexity, gender,
+                    paces, genres, handshakes, deleted_at, media_status, photo_variants)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (id) DO UPDATE
+    SET translation_id = EXCLUDED.translation_id,
+        name           = EXCLUDED.name,
+        photo_key      = EXCLUDED.photo_key,
+        complexity     = EXCLUDED.complexity,
+        gender         = EXCLUDED.gender,
+        paces          = EXCLUDED.paces,
+        genres         = EXCLUDED.genres,
+        handshakes     = EXCLUDED.handshakes,
+        media_status   = EXCLUDED.media_status,
+        photo_variants = EXCLUDED.photo_variants,
+        deleted_at     = CASE WHEN EXCLUDED.deleted_at IS NULL THEN NULL ELSE COALESCE(dances.deleted_at, EXCLUDED.deleted_at) END,
+        updated_at     = NOW()
+WHERE NOT dances.modified_in_admin
+  AND ((dances.deleted_at IS NULL) <> (EXCLUDED.deleted_at IS NULL)
+    OR (dances.translation_id, dances.name, dances.photo_key, dances.complexity, dances.gender,
+        dances.paces, dances.genres, dances.handshakes, dances.media_status, dances.photo_variants)
+     IS DISTINCT FROM
+       (EXCLUDED.translation_id, EXCLUDED.name, EXCLUDED.photo_key, EXCLUDED.complexity, EXCLUDED.gender,
+        EXCLUDED.paces, EXCLUDED.genres, EXCLUDED.handshakes, EXCLUDED.media_status, EXCLUDED.photo_variants))
+`
+
+type UpsertDanceParams struct {
+	ID            int64              `json:"id"`
+	TranslationID pgtype.Int8        `json:"translation_id"`
+	Name          string             `json:"name"`
+	PhotoKey      pgtype.Text        `json:"photo_key"`
+	Complexity    pgtype.Int4        `json:"complexity"`
+	Gender        string             `json:"gender"`
+	Paces         []int32            `json:"paces"`
+	Genres        []string           `json:"genres"`
+	Handshakes    []string           `json:"handshakes"`
+	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+	MediaStatus   string             `json:"media_status"`
+	PhotoVariants []byte             `json:"photo_variants"`
+}
+
+func (q *Queries) UpsertDance(ctx context.Context, arg UpsertDanceParams) error {
+	_, err := q.db.Exec(ctx, upsertDance,
+		arg.ID,
+		arg.TranslationID,
+		arg.Name,
+		arg.PhotoKey,
+		arg.Complexity,
+		arg.Gender,
+		arg.Paces,
+		arg.Genres,
+		arg.Handshakes,
+		arg.DeletedAt,
+		arg.MediaStatus,
+		arg.PhotoVariants,
+	)
+	return err
+}
+
+const upsertRegions = `-- name: UpsertRegions :exec
+INSERT INTO regions (id, translation_id, name)
+SELECT unnest($1::bigint[])             as id,
+       unnest($2::bigint[]) as translation_id,
+       unnest($3::text[])             as name
+ON CONFLICT (id) DO UPDATE
+    SET translation_id = EXCLUDED.translation_id,
+        name           = EXCLUDED.name,
+        deleted_at     = NULL,
+        updated_at     = NOW()
+WHERE regions.deleted_at IS NOT NULL
+   OR (regions.translation_id, regions.name) IS DISTINCT FROM (EXCLUDED.translation_id, EXCLUDED.name)
+`
+
+type UpsertRegionsParams struct {
+	Ids            []int64  `json:"ids"`
+	TranslationIds []int64  `json:"translation_ids"`
+	Names          []string `json:"names"`
+}
+
+func (q *Queries) UpsertRegions(ctx context.Context, arg UpsertRegionsParams) error {
+	_, err := q.db.Exec(ctx, upsertRegions, arg.Ids, arg.TranslationIds, arg.Names)
+	return err
+}
+
+const upsertSongs = `-- name: UpsertSongs :exec
+INSERT INTO songs (id, translation_id, name, file_key, lyrics_html, lyrics_text, media_status,
+                   duration_ms, bitrate_kbps, sample_rate_hz, tag_title, tag_artist)
+SELECT unnest($1::bigint[])                    as id,
+       unnest($2::bigint[])        as translation_id,
+       unnest($3::text[])                    as name,
+       unnest($4::text[])                as file_key,
+       unnest($5::text[])             as lyrics_html,
+       unnest($6::text[])             as lyrics_text,
+       unnest($7::text[])           as media_status,
+       NULLIF(unnest($8::int[]), 0)   as duration_ms,
+       NULLIF(unnest($9::int[]), 0)  as bitrate_kbps,
+       NULLIF(unnest($10::int[]), 0) as sample_rate_hz,
+       unnest($11::text[])               as tag_title,
+       unnest($12::text[])              as tag_artist
+ON CONFLICT (id) DO UPDATE
+    SET translation_id = EXCLUDED.translation_id,
+        name           = EXCLUDED.name,
+        file_key       = EXCLUDED.file_key,
+        lyrics_html    = EXCLUDED.lyrics_html,
+        lyrics_text    = EXCLUDED.lyrics_text,
+        media_status   = EXCLUDED.media_status,
+        duration_ms    = EXCLUDED.duration_ms,
+        bitrate_kbps   = EXCLUDED.bitrate_kbps,
+        sample_rate_hz = EXCLUDED.sample_rate_hz,
+        tag_title      = EXCLUDED.tag_title,
+        tag_artist     = EXCLUDED.tag_artist,
+        deleted_at     = NULL,
+        updated_at     = NOW()
+WHERE NOT songs.modified_in_admin
+  AND (songs.deleted_at IS NOT NULL
+    OR (songs.translation_id, songs.name, songs.file_key, songs.lyrics_html, songs.lyrics_text, songs.media_status,
+        songs.duration_ms, songs.bitrate_kbps, songs.sample_rate_hz, songs.tag_title, songs.tag_artist)
+     IS DISTINCT FROM
+       (EXCLUDED.translation_id, EXCLUDED.name, EXCLUDED.file_key, EXCLUDED.lyrics_html, EXCLUDED.lyrics_text, EXCLUDED.media_status,
+        EXCLUDED.duration_ms, EXCLUDED.bitrate_kbps, EXCLUDED.sample_rate_hz, EXCLUDED.tag_title, EXCLUDED.tag_artist))
+`
+
+type UpsertSongsParams struct {
+	Ids            []int64  `json:"ids"`
+	TranslationIds []int64  `json:"translation_ids"`
+	Names          []string `json:"names"`
+	FileKeys       []string `json:"file_keys"`
+	LyricsHtmls    []string `json:"lyrics_htmls"`
+	LyricsTexts    []string `json:"lyrics_texts"`
+	MediaStatuses  []string `json:"media_statuses"`
+	DurationsMs    []int32  `json:"durations_ms"`
+	BitratesKbps   []int32  `json:"bitrates_kbps"`
+	SampleRatesHz  []int32  `json:"sample_rates_hz"`
+	TagTitles      []string `json:"tag_titles"`
+	TagArtists     []string `json:"tag_artists"`
+}
+
+func (q *Queries) UpsertSongs(ctx context.Context, arg UpsertSongsParams) error {
+	_, err := q.db.Exec(ctx, upsertSongs,
+		arg.Ids,
+		arg.TranslationIds,
+		arg.Names,
+		arg.FileKeys,
+		arg.LyricsHtmls,
+		arg.LyricsTexts,
+		arg.MediaStatuses,
+		arg.DurationsMs,
+		arg.BitratesKbps,
+		arg.SampleRatesHz,
+		arg.TagTitles,
+		arg.TagArtists,
+	)
+	return err
+}
@@ -12,7 +12,7 @@ import (
 )
 
 const getDanceByID = `-- name: GetDanceByID :one
-SELECT d.id, d.complexity, d.photo_key, d.gender, d.paces, d.genres, d.handshakes,
+SELECT d.id, d.complexity, d.photo_key, d.photo_variants, d.gender, d.paces, d.genres, d.handshakes,
 COALESCE(CASE WHEN $2::text = 'ru' THEN t.ru_name WHEN $2::text = 'en' THEN t.eng_name WHEN $2::text = 'hy' THEN t.arm_name ELSE d.name END, d.name)::text AS name
 FROM dances d
 LEFT JOIN translations t ON d.translation_id = t.id
@@ -25,14 +25,15 @@ type GetDanceByIDParams struct {
 }
 
 type GetDanceByIDRow struct {
-	ID         int64       `json:"id"`
-	Complexity pgtype.Int4 `json:"complexity"`
-	PhotoKey   pgtype.Text `json:"photo_key"`
-	Gender     string      `json:"gender"`
-	Paces      []int32     `json:"paces"`
-	Genres     []string    `json:"genres"`
-	Handshakes []string    `json:"handshakes"`
-	Name       string      `json:"name"`
+	ID            int64       `json:"id"`
+	Complexity    pgtype.Int4 `json:"complexity"`
+	PhotoKey      pgtype.Text `json:"photo_key"`
+	PhotoVariants []byte      `json:"photo_variants"`
+	Gender        string      `json:"gender"`
+	Paces         []int32     `json:"paces"`
+	Genres        []string    `json:"genres"`
+	Handshakes    []string    `json:"handshakes"`
+	Name          string      `json:"name"`
 }
 
 func (q *Queries) GetDanceByID(ctx context.Context, arg GetDanceByIDParams) (GetDanceByIDRow, error) {
@@ -42,6 +43,7 @@ func (q *Queries) GetDanceByID(ctx context.Context, arg GetDanceByIDParams) (Get
 		&i.ID,
 		&i.Complexity,
 		&i.PhotoKey,
+		&i.PhotoVariants,
 		&i.Gender,
 		&i.Paces,
 		&i.Genres,
@@ -117,6 +119,7 @@ FROM regions r
 LEFT JOIN translations t ON r.translation_id = t.id
 JOIN dance_region dr ON dr.region_id = r.id
 WHERE dr.dance_id = $1
+  AND r.deleted_at IS NULL
 `
 
 type GetRegionsByDanceIDParams struct {
@@ -161,11 +164,20 @@ SELECT
         END, 
         s.name
     )::text AS name,
-    s.file_key 
+    s.file_key,
+    s.lyrics_html,
+    s.lyrics_text,
+    s.media_status,
+    s.duration_ms,
+    s.bitrate_kbps,
+    s.sample_rate_hz,
+    s.tag_title,
+    s.tag_artist
 FROM songs s 
 LEFT JOIN translations t ON s.translation_id = t.id
 JOIN dance_song ds ON ds.song_id = s.id 
 WHERE ds.dance_id = $1
+  AND s.deleted_at IS NULL
 `
 
 type GetSongsByDanceIDParams struct {
@@ -174,9 +186,17 @@ type GetSongsByDanceIDParams struct {
 }
 
 type GetSongsByDanceIDRow struct {
-	ID      int64  `json:"id"`
-	Name    string `json:"name"`
-	FileKey string `json:"file_key"`
+	ID           int64       `json:"id"`
+	Name         string      `json:"name"`
+	FileKey      string      `json:"file_key"`
+	LyricsHtml   string      `json:"lyrics_html"`
+	LyricsText   string      `json:"lyrics_text"`
+	MediaStatus  string      `json:"media_status"`
+	DurationMs   pgtype.Int4 `json:"duration_ms"`
+	BitrateKbps  pgtype.Int4 `json:"bitrate_kbps"`
+	SampleRateHz pgtype.Int4 `json:"sample_rate_hz"`
+	TagTitle     string      `json:"tag_title"`
+	TagArtist    string      `json:"tag_artist"`
 }
 
 func (q *Queries) GetSongsByDanceID(ctx context.Context, arg GetSongsByDanceIDParams) ([]GetSongsByDanceIDRow, error) {
@@ -188,7 +208,19 @@ func (q *Queries) GetSongsByDanceID(ctx context.Context, arg GetSongsByDanceIDPa
 	items := []GetSongsByDanceIDRow{}
 	for rows.Next() {
 		var i GetSongsByDanceIDRow
-		if err := rows.Scan(&i.ID, &i.Name, &i.FileKey); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.FileKey,
+			&i.LyricsHtml,
+			&i.LyricsText,
+			&i.MediaStatus,
+			&i.DurationMs,
+			&i.BitrateKbps,
+			&i.SampleRateHz,
+			&i.TagTitle,
+			&i.TagArtist,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -217,6 +249,7 @@ FROM videos v
 LEFT JOIN translations t ON v.translation_id = t.id
 JOIN dance_videos dv ON dv.video_id = v.id 
 WHERE dv.dance_id = $1
+  AND v.deleted_at IS NULL
 `
 
 type GetVideosByDanceIDParams struct {
@@ -26,6 +26,7 @@ SELECT
     ) AS name,
     d.complexity,
     d.photo_key         AS photo_link,
+    d.photo_variants,
     d.gender,
     d.paces,
     d.genres,
@@ -121,6 +122,7 @@ GROUP BY
     ),
     d.complexity,
     d.photo_key,
+    d.photo_variants,
     d.gender,
     d.paces,
     d.genres,
@@ -181,6 +183,7 @@ type SearchDancesRow struct {
 	Name          string             `json:"name"`
 	Complexity    pgtype.Int4        `json:"complexity"`
 	PhotoLink     pgtype.Text        `json:"photo_link"`
+	PhotoVariants []byte             `json:"photo_variants"`
 	Gender        string             `json:"gender"`
 	Paces         []int32            `json:"paces"`
 	Genres        []string           `json:"genres"`
@@ -222,6 +225,7 @@ func (q *Queries) SearchDances(ctx context.Context, arg SearchDancesParams) ([]S
 			&i.Name,
 			&i.Complexity,
 			&i.PhotoLink,
+			&i.PhotoVariants,
 			&i.Gender,
 			&i.Paces,
 			&i.Genres,
@@ -8,6 +8,17 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLog struct {
+	ID          int64              `json:"id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	Actor       string             `json:"actor"`
+	EntityType  string             `json:"entity_type"`
+	EntityID    int64              `json:"entity_id"`
+	Action      string             `json:"action"`
+	Diff        []byte             `json:"diff"`
+	ImportRunID pgtype.UUID        `json:"import_run_id"`
+}
+
 type Artist struct {
 	ID            int64              `json:"id"`
 	TranslationID pgtype.Int8        `json:"translation_id"`
@@ -19,19 +30,23 @@ type Artist struct {
 }
 
 type Dance struct {
-	ID            int64              `json:"id"`
-	TranslationID pgtype.Int8        `json:"translation_id"`
-	Name          string             `json:"name"`
-	Complexity    pgtype.Int4        `json:"complexity"`
-	PhotoKey      pgtype.Text        `json:"photo_key"`
-	Gender        string             `json:"gender"`
-	Paces         []int32            `json:"paces"`
-	Popularity    int32              `json:"popularity"`
-	Genres        []string           `json:"genres"`
-	Handshakes    []string           `json:"handshakes"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
-	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+	ID              int64              `json:"id"`
+	TranslationID   pgtype.Int8        `json:"translation_id"`
+	Name            string             `json:"name"`
+	Complexity      pgtype.Int4        `json:"complexity"`
+	PhotoKey        pgtype.Text        `json:"photo_key"`
+	Gender          string             `json:"gender"`
+	Paces           []int32            `json:"paces"`
+	Popularity      int32              `json:"popularity"`
+	Genres          []string           `json:"genres"`
+	Handshakes      []string           `json:"handshakes"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	MediaStatus     string             `json:"media_status"`
+	PhotoVariants   []byte             `json:"photo_variants"`
+	CreatedInAdmin  bool               `json:"created_in_admin"`
+	ModifiedInAdmin bool               `json:"modified_in_admin"`
 }
 
 type DanceRegion struct {
@@ -42,6 +57,28 @@ type DanceRegion struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type DanceRevision struct {
+	ID          int64              `json:"id"`
+	DanceID     int64              `json:"dance_id"`
+	Rev         int32              `json:"rev"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	Actor       pgtype.Text        `json:"actor"`
+	ImportRunID pgtype.UUID        `json:"import_run_id"`
+	Name        string             `json:"name"`
+	EngName     pgtype.Text        `json:"eng_name"`
+	RuName      pgtype.Text        `json:"ru_name"`
+	ArmName     pgtype.Text        `json:"arm_name"`
+	Complexity  pgtype.Int4        `json:"complexity"`
+	Gender      string             `json:"gender"`
+	Paces       []int32            `json:"paces"`
+	Genres      []string           `json:"genres"`
+	Handshakes  []string           `json:"handshakes"`
+	Deleted     bool               `json:"deleted"`
+	RegionIds   []int64            `json:"region_ids"`
+	SongIds     []int64            `json:"song_ids"`
+	VideoIds    []int64            `json:"video_ids"`
+}
+
 type DanceSong struct {
 	ID        int64              `json:"id"`
 	DanceID   int64              `json:"dance_id"`
@@ -58,21 +95,47 @@ type DanceVideo struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
-type Region struct {
+type Group struct {
 	ID            int64              `json:"id"`
 	TranslationID pgtype.Int8        `json:"translation_id"`
 	Name          string             `json:"name"`
+	Flag          string             `json:"flag"`
+	CountryCode   string             `json:"country_code"`
+	Link          string             `json:"link"`
+	Type          string             `json:"type"`
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
 }
 
-type Song struct {
+type Region struct {
 	ID            int64              `json:"id"`
 	TranslationID pgtype.Int8        `json:"translation_id"`
-	FileKey       string             `json:"file_key"`
 	Name          string             `json:"name"`
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+}
+
+type Song struct {
+	ID              int64              `json:"id"`
+	TranslationID   pgtype.Int8        `json:"translation_id"`
+	FileKey         string             `json:"file_key"`
+	Name            string             `json:"name"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	LyricsHtml      string             `json:"lyrics_html"`
+	LyricsText      string             `json:"lyrics_text"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	MediaStatus     string             `json:"media_status"`
+	PlayCount       int32              `json:"play_count"`
+	DurationMs      pgtype.Int4        `json:"duration_ms"`
+	BitrateKbps     pgtype.Int4        `json:"bitrate_kbps"`
+	SampleRateHz    pgtype.Int4        `json:"sample_rate_hz"`
+	TagTitle        string             `json:"tag_title"`
+	TagArtist       string             `json:"tag_artist"`
+	CreatedInAdmin  bool               `json:"created_in_admin"`
+	ModifiedInAdmin bool               `json:"modified_in_admin"`
 }
 
 type SongArtist struct {
@@ -93,11 +156,14 @@ type Translation struct {
 }
 
 type Video struct {
-	ID            int64              `json:"id"`
-	Link          string             `json:"link"`
-	TranslationID pgtype.Int8        `json:"translation_id"`
-	Name          string             `json:"name"`
-	Type          string             `json:"type"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	ID              int64              `json:"id"`
+	Link            string             `json:"link"`
+	TranslationID   pgtype.Int8        `json:"translation_id"`
+	Name            string             `json:"name"`
+	Type            string             `json:"type"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	CreatedInAdmin  bool               `json:"created_in_admin"`
+	ModifiedInAdmin bool               `json:"modified_in_admin"`
 }
@@ -11,34 +11,110 @@ import (
 )
 
 type Querier interface {
+	CountSongsByFileKey(ctx context.Context, fileKey string) (int64, error)
+	CreateDance(ctx context.Context, arg CreateDanceParams) (int64, error)
+	CreateSong(ctx context.Context, arg CreateSongParams) (int64, error)
+	CreateVideo(ctx context.Context, arg CreateVideoParams) (int64, error)
+	DanceExists(ctx context.Context, id int64) (bool, error)
+	DeleteDanceRegionsExcept(ctx context.Context, arg DeleteDanceRegionsExceptParams) error
+	DeleteDanceSongsExcept(ctx context.Context, arg DeleteDanceSongsExceptParams) error
+	DeleteDanceVideosExcept(ctx context.Context, arg DeleteDanceVideosExceptParams) error
+	DeleteSongArtistsExcept(ctx context.Context, arg DeleteSongArtistsExceptParams) error
+	DeleteSongDancesExcept(ctx context.Context, arg DeleteSongDancesExceptParams) error
+	DeleteStaleDanceRegions(ctx context.Context, arg DeleteStaleDanceRegionsParams) error
+	DeleteStaleDanceSongs(ctx context.Context, arg DeleteStaleDanceSongsParams) error
+	DeleteStaleDanceVideos(ctx context.Context, arg DeleteStaleDanceVideosParams) error
+	DeleteStaleSongArtists(ctx context.Context, arg DeleteStaleSongArtistsParams) error
+	DeleteVideoDancesExcept(ctx context.Context, arg DeleteVideoDancesExceptParams) error
+	FilterRegionIDs(ctx context.Context, ids []int64) ([]int64, error)
+	FilterSongIDs(ctx context.Context, ids []int64) ([]int64, error)
+	FilterVideoIDs(ctx context.Context, ids []int64) ([]int64, error)
+	GetActiveVideoLinks(ctx context.Context) ([]GetActiveVideoLinksRow, error)
+	GetAdminDance(ctx context.Context, id int64) (GetAdminDanceRow, error)
+	GetAdminSong(ctx context.Context, id int64) (GetAdminSongRow, error)
+	GetAdminVideo(ctx context.Context, id int64) (GetAdminVideoRow, error)
 	GetArtists(ctx context.Context) ([]GetArtistsRow, error)
 	GetDanceByID(ctx context.Context, arg GetDanceByIDParams) (GetDanceByIDRow, error)
+	GetDanceRegionIDs(ctx context.Context, danceID int64) ([]int64, error)
 	GetDanceRegions(ctx context.Context) ([]GetDanceRegionsRow, error)
+	GetDanceRevision(ctx context.Context, arg GetDanceRevisionParams) (DanceRevision, error)
 	GetDanceSongs(ctx context.Context) ([]GetDanceSongsRow, error)
 	GetDanceVideos(ctx context.Context) ([]GetDanceVideosRow, error)
 	GetDances(ctx context.Context) ([]GetDancesRow, error)
+	GetDancesByArtistIDs(ctx context.Context, arg GetDancesByArtistIDsParams) ([]GetDancesByArtistIDsRow, error)
+	GetDancesBySongIDs(ctx context.Context, arg GetDancesBySongIDsParams) ([]GetDancesBySongIDsRow, error)
+	GetEnsembleByID(ctx context.Context, arg GetEnsembleByIDParams) (GetEnsembleByIDRow, error)
 	GetEnsemblesBySongID(ctx context.Context, arg GetEnsemblesBySongIDParams) ([]GetEnsemblesBySongIDRow, error)
+	GetEnsemblesBySongIDs(ctx context.Context, arg GetEnsemblesBySongIDsParams) ([]GetEnsemblesBySongIDsRow, error)
+	GetExistingArtistIDs(ctx context.Context, ids []int64) ([]int64, error)
+	GetExistingDanceIDs(ctx context.Context, ids []int64) ([]int64, error)
+	GetExistingRegionIDs(ctx context.Context, ids []int64) ([]int64, error)
+	GetGroups(ctx context.Context) ([]GetGroupsRow, error)
+	GetReferencedFileKeys(ctx context.Context) ([]string, error)
 	GetRegions(ctx context.Context) ([]GetRegionsRow, error)
 	GetRegionsByDanceID(ctx context.Context, arg GetRegionsByDanceIDParams) ([]GetRegionsByDanceIDRow, error)
+	GetSongArtistIDs(ctx context.Context, songID int64) ([]int64, error)
+	GetSongArtists(ctx context.Context) ([]GetSongArtistsRow, error)
+	GetSongByID(ctx context.Context, arg GetSongByIDParams) (GetSongByIDRow, error)
+	GetSongDanceIDs(ctx context.Context, songID int64) ([]int64, error)
+	GetSongLyrics(ctx context.Context, id int64) (GetSongLyricsRow, error)
 	GetSongs(ctx context.Context) ([]GetSongsRow, error)
+	GetSongsByArtistIDs(ctx context.Context, arg GetSongsByArtistIDsParams) ([]GetSongsByArtistIDsRow, error)
 	GetSongsByDanceID(ctx context.Context, arg GetSongsByDanceIDParams) ([]GetSongsByDanceIDRow, error)
 	GetTranslations(ctx context.Context) ([]GetTranslationsRow, error)
+	GetVideoDanceIDs(ctx context.Context, videoID int64) ([]int64, error)
 	GetVideos(ctx context.Context) ([]GetVideosRow, error)
 	GetVideosByDanceID(ctx context.Context, arg GetVideosByDanceIDParams) ([]GetVideosByDanceIDRow, error)
 	IncrementDancePopularity(ctx context.Context, id int64) error
+	IncrementSongPlayCount(ctx context.Context, id int64) error
 	InsertArtists(ctx context.Context, arg InsertArtistsParams) error
+	InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) error
 	InsertDance(ctx context.Context, arg InsertDanceParams) error
 	InsertDanceRegions(ctx context.Context, arg InsertDanceRegionsParams) error
+	InsertDanceRevisions(ctx context.Context, arg InsertDanceRevisionsParams) error
 	InsertDanceSongs(ctx context.Context, arg InsertDanceSongsParams) error
 	InsertDanceVideos(ctx context.Context, arg InsertDanceVideosParams) error
+	InsertGroups(ctx context.Context, arg InsertGroupsParams) error
 	InsertRegions(ctx context.Context, arg InsertRegionsParams) error
 	InsertSongArtists(ctx context.Context, arg InsertSongArtistsParams) error
 	InsertSongs(ctx context.Context, arg InsertSongsParams) error
 	InsertTranslations(ctx context.Context, arg InsertTranslationsParams) ([]int64, error)
 	InsertVideos(ctx context.Context, arg InsertVideosParams) ([]int64, error)
+	ListAuditLog(ctx context.Context, arg ListAuditLogParams) ([]AuditLog, error)
+	ListDanceRevisions(ctx context.Context, danceID int64) ([]DanceRevision, error)
+	ListEnsembles(ctx context.Context, arg ListEnsemblesParams) ([]ListEnsemblesRow, error)
+	ListGroups(ctx context.Context, arg ListGroupsParams) ([]ListGroupsRow, error)
 	ListRegions(ctx context.Context, lang pgtype.Text) ([]ListRegionsRow, error)
+	ListSongs(ctx context.Context, arg ListSongsParams) ([]ListSongsRow, error)
+	LockDance(ctx context.Context, id int64) (pgtype.Int8, error)
+	LockDanceForRestore(ctx context.Context, id int64) (pgtype.Int8, error)
+	LockDancesForRevision(ctx context.Context, ids []int64) error
+	LockSong(ctx context.Context, id int64) (LockSongRow, error)
+	LockVideo(ctx context.Context, id int64) (pgtype.Int8, error)
+	LockVideoLinks(ctx context.Context) error
+	RestoreDance(ctx context.Context, arg RestoreDanceParams) error
 	SearchDances(ctx context.Context, arg SearchDancesParams) ([]SearchDancesRow, error)
+	SetSongAudio(ctx context.Context, arg SetSongAudioParams) error
+	SoftDeleteDance(ctx context.Context, id int64) (int64, error)
+	SoftDeleteMissingArtists(ctx context.Context, ids []int64) error
+	SoftDeleteMissingDances(ctx context.Context, ids []int64) error
+	SoftDeleteMissingGroups(ctx context.Context, ids []int64) error
+	SoftDeleteMissingRegions(ctx context.Context, ids []int64) error
+	SoftDeleteMissingSongs(ctx context.Context, ids []int64) error
+	SoftDeleteMissingVideos(ctx context.Context, ids []int64) error
+	SoftDeleteSong(ctx context.Context, id int64) (int64, error)
+	SoftDeleteVideo(ctx context.Context, id int64) (int64, error)
 	TruncateAllTables(ctx context.Context) error
+	UpdateDance(ctx context.Context, arg UpdateDanceParams) error
+	UpdateGroups(ctx context.Context, arg UpdateGroupsParams) error
+	UpdateSong(ctx context.Context, arg UpdateSongParams) error
+	UpdateTranslations(ctx context.Context, arg UpdateTranslationsParams) error
+	UpdateVideo(ctx context.Context, arg UpdateVideoParams) error
+	UpdateVideos(ctx context.Context, arg UpdateVideosParams) error
+	UpsertArtists(ctx context.Context, arg UpsertArtistsParams) error
+	UpsertDance(ctx context.Context, arg UpsertDanceParams) error
+	UpsertRegions(ctx context.Context, arg UpsertRegionsParams) error
+	UpsertSongs(ctx context.Context, arg UpsertSongsParams) error
 }
 
 var _ Querier = (*Queries)(nil)
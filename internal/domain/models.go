@@ -30,6 +30,16 @@ const (
 	Amulet      Genre = "AMULET"
 )
 
+// Valid сообщает, входит ли значение в перечисление
+func (g Genre) Valid() bool {
+	switch g {
+	case War, Road, Cult, Lyrical, Reverse, Ritual, Community, Hunting, Pilgrimage,
+		Memorable, Memorial, Funeral, Festive, Wedding, Matchmakers, Labor, Amulet:
+		return true
+	}
+	return false
+}
+
 type HoldingType string
 
 const (
@@ -44,6 +54,14 @@ const (
 	Whip         HoldingType = "WHIP"
 )
 
+func (h HoldingType) Valid() bool {
+	switch h {
+	case Free, LittleFinger, Palm, Crossed, Back, Belt, Shoulder, Dagger, Whip:
+		return true
+	}
+	return false
+}
+
 type Gender string
 
 const (
@@ -52,6 +70,34 @@ const (
 	Multi  Gender = "MULTY"
 )
 
+func (g Gender) Valid() bool {
+	switch g {
+	case Male, Female, Multi:
+		return true
+	}
+	return false
+}
+
+// MediaStatus — удалось ли при импорте найти и прочитать фото танца или аудио песни
+type MediaStatus string
+
+const (
+	MediaOk         MediaStatus = "OK"
+	MediaMissing    MediaStatus = "MISSING"
+	MediaUnreadable MediaStatus = "UNREADABLE"
+	// MediaCorrupt — файл загружен, но в нём не нашлось аудиоданных
+	MediaCorrupt MediaStatus = "CORRUPT"
+)
+
+// PhotoVariant — уменьшенная копия фото танца: размер thumb, card или full в формате jpeg или webp
+type PhotoVariant struct {
+	Size   string `json:"size"`
+	Format string `json:"format"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Key    string `json:"key"`
+}
+
 type Region struct {
 	Id   int64
 	Name Translation
@@ -69,6 +115,18 @@ type DanceShort struct {
 	Genres       []Genre
 	RegionIds    []int64
 	DeletedAt    *time.Time
+	MediaStatus  MediaStatus
+	// Уменьшенные копии FileKey, пусто если фото нет
+	PhotoVariants []PhotoVariant
+}
+
+// AudioInfo — данные из заголовков MP3 и ID3-тегов, нули и пустые строки если их не удалось прочитать
+type AudioInfo struct {
+	DurationMs   int
+	BitrateKbps  int
+	SampleRateHz int
+	Title        string
+	Artist       string
 }
 
 type SongShort struct {
@@ -78,6 +136,11 @@ type SongShort struct {
 	NameKey   string
 	DanceIds  []int64
 	ArtistIds []int64
+	// Текст песни: разметка из безопасного подмножества тегов и чистый текст
+	LyricsHTML  string
+	LyricsText  string
+	MediaStatus MediaStatus
+	Audio       AudioInfo
 }
 
 type VideoType string
@@ -88,6 +151,14 @@ const (
 	Source VideoType = "SOURCE"
 )
 
+func (t VideoType) Valid() bool {
+	switch t {
+	case Lesson, Video, Source:
+		return true
+	}
+	return false
+}
+
 type VideoShort struct {
 	Id       *int64
 	Name     Translation
@@ -104,3 +175,22 @@ type ArtistShort struct {
 	Url       string
 	DeletedAt *time.Time
 }
+
+type GroupType string
+
+const (
+	Diaspora     GroupType = "DIASPORA"
+	Yerevan      GroupType = "YEREVAN"
+	State        GroupType = "STATE"
+	Organization GroupType = "ORGANIZATION"
+)
+
+type Group struct {
+	Id          *int64
+	Name        Translation
+	NameKey     string
+	Flag        string
+	CountryCode string
+	Url         string
+	Type        GroupType
+}
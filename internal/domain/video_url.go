@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// NormalizeVideoURL приводит ссылку на видео к каноничному виду, чтобы одинаковые ролики
+// из разных источников (youtu.be, m.youtube.com, shorts) совпадали при сравнении.
+// У других сайтов убираются схема, www, завершающий слеш, фрагмент и метки трекинга, а остальные
+// параметры сортируются: у vk video_ext.php?oid=1&id=2 ролик задаётся именно ими.
+func NormalizeVideoURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+
+	host := strings.ToLower(u.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	host = strings.TrimPrefix(host, "m.")
+
+	if id := youtubeVideoID(host, u); id != "" {
+		return "youtube.com/watch?v=" + id
+	}
+
+	key := host + strings.TrimSuffix(u.EscapedPath(), "/")
+	query := u.Query()
+	for name := range query {
+		if strings.HasPrefix(name, "utm_") || trackingParams[name] {
+			query.Del(name)
+		}
+	}
+	if len(query) > 0 {
+		// Encode сортирует параметры по имени
+		key += "?" + query.Encode()
+	}
+	return key
+}
+
+// trackingParams — параметры, которые добавляют соцсети и реклама; на ролик они не влияют
+var trackingParams = map[string]bool{
+	"fbclid": true,
+	"gclid":  true,
+	"yclid":  true,
+	"igshid": true,
+	"igsh":   true,
+}
+
+func youtubeVideoID(host string, u *url.URL) string {
+	switch host {
+	case "youtu.be":
+		return strings.Trim(u.Path, "/")
+	case "youtube.com", "music.youtube.com":
+		if v := u.Query().Get("v"); v != "" {
+			return v
+		}
+		for _, prefix := range []string{"/shorts/", "/embed/", "/live/"} {
+			if strings.HasPrefix(u.Path, prefix) {
+				return strings.Trim(strings.TrimPrefix(u.Path, prefix), "/")
+			}
+		}
+	}
+	return ""
+}
+
+var youtubeIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// YouTubeVideo — ролик YouTube и секунда, с которой его нужно начать
+type YouTubeVideo struct {
+	ID           string
+	StartSeconds int
+}
+
+// ParseYouTubeURL разбирает ссылки youtu.be, watch?v=, shorts, embed и live.
+// Время старта берётся из параметра t или start: 90, 90s, 1m30s. ok=false для других сайтов
+// и ссылок без правильного id ролика
+func ParseYouTubeURL(raw string) (video YouTubeVideo, ok bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return YouTubeVideo{}, false
+	}
+	host := strings.ToLower(u.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	host = strings.TrimPrefix(host, "m.")
+
+	id := youtubeVideoID(host, u)
+	if !youtubeIDPattern.MatchString(id) {
+		return YouTubeVideo{}, false
+	}
+
+	video.ID = id
+	start := u.Query().Get("t")
+	if start == "" {
+		start = u.Query().Get("start")
+	}
+	if fragment, err := url.ParseQuery(u.Fragment); start == "" && err == nil {
+		start = fragment.Get("t")
+	}
+	video.StartSeconds = parseYouTubeTime(start)
+	return video, true
+}
+
+// IsYouTubeURL отличает ссылку на YouTube с неправильным id от ссылки на другой сайт
+func IsYouTubeURL(raw string) bool {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return host == "youtu.be" || host == "youtube.com" || strings.HasSuffix(host, ".youtube.com")
+}
+
+// WatchURL — каноничная ссылка на ролик, в таком виде админка хранит ссылки YouTube
+func (v YouTubeVideo) WatchURL() string {
+	link := "https://www.youtube.com/watch?v=" + v.ID
+	if v.StartSeconds > 0 {
+		link += "&t=" + strconv.Itoa(v.StartSeconds) + "s"
+	}
+	return link
+}
+
+// EmbedURL — ссылка для iframe
+func (v YouTubeVideo) EmbedURL() string {
+	link := "https://www.youtube.com/embed/" + v.ID
+	if v.StartSeconds > 0 {
+		link += "?start=" + strconv.Itoa(v.StartSeconds)
+	}
+	return link
+}
+
+// parseYouTubeTime понимает секунды числом и запись вида 1h2m3s; непонятное значение — старт с начала
+func parseYouTubeTime(value string) int {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return seconds
+	}
+
+	total, number := 0, 0
+	digits := false
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			number = number*10 + int(r-'0')
+			digits = true
+			continue
+		case r == 'h' && digits:
+			total += number * 3600
+		case r == 'm' && digits:
+			total += number * 60
+		case r == 's' && digits:
+			total += number
+		default:
+			return 0
+		}
+		number, digits = 0, false
+	}
+	if digits {
+		return 0
+	}
+	return total
+}
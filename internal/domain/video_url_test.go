@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeVideoURL(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"https://youtu.be/Wk61XUxP2Mg", "youtube.com/watch?v=Wk61XUxP2Mg"},
+		{"https://youtu.be/Wk61XUxP2Mg?si=abc", "youtube.com/watch?v=Wk61XUxP2Mg"},
+		{"https://www.youtube.com/watch?v=Wk61XUxP2Mg&t=30s", "youtube.com/watch?v=Wk61XUxP2Mg"},
+		{"http://m.youtube.com/watch?v=Wk61XUxP2Mg", "youtube.com/watch?v=Wk61XUxP2Mg"},
+		{"https://youtube.com/shorts/Wk61XUxP2Mg/", "youtube.com/watch?v=Wk61XUxP2Mg"},
+		{" https://WWW.Instagram.com/reel/abc/ ", "instagram.com/reel/abc"},
+		{"https://www.instagram.com/reel/abc/?igsh=xyz&utm_source=ig_web#comments", "instagram.com/reel/abc"},
+		{"https://vk.com/video_ext.php?oid=1&id=2", "vk.com/video_ext.php?id=2&oid=1"},
+		{"https://vk.com/video_ext.php?id=2&oid=1&utm_campaign=share", "vk.com/video_ext.php?id=2&oid=1"},
+		{"https://vk.com/video_ext.php?oid=3&id=4", "vk.com/video_ext.php?id=4&oid=3"},
+		{"not a url", "not a url"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NormalizeVideoURL(tt.raw), tt.raw)
+	}
+}
+
+func TestParseYouTubeURL(t *testing.T) {
+	tests := []struct {
+		raw   string
+		want  YouTubeVideo
+		valid bool
+	}{
+		{"https://youtu.be/Wk61XUxP2Mg", YouTubeVideo{ID: "Wk61XUxP2Mg"}, true},
+		{"https://youtu.be/Wk61XUxP2Mg?t=90", YouTubeVideo{ID: "Wk61XUxP2Mg", StartSeconds: 90}, true},
+		{"https://www.youtube.com/watch?v=Wk61XUxP2Mg&t=1m30s", YouTubeVideo{ID: "Wk61XUxP2Mg", StartSeconds: 90}, true},
+		{"https://m.youtube.com/watch?v=Wk61XUxP2Mg#t=1h2s", YouTubeVideo{ID: "Wk61XUxP2Mg", StartSeconds: 3602}, true},
+		{"https://youtube.com/shorts/Wk61XUxP2Mg/", YouTubeVideo{ID: "Wk61XUxP2Mg"}, true},
+		{"https://www.youtube.com/embed/Wk61XUxP2Mg?start=15", YouTubeVideo{ID: "Wk61XUxP2Mg", StartSeconds: 15}, true},
+		{"https://www.youtube.com/watch?v=Wk61XUxP2Mg&t=soon", YouTubeVideo{ID: "Wk61XUxP2Mg"}, true},
+		{"https://www.youtube.com/watch?v=short", YouTubeVideo{}, false},
+		{"https://www.youtube.com/channel/abc", YouTubeVideo{}, false},
+		{"https://instagram.com/reel/abc", YouTubeVideo{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseYouTubeURL(tt.raw)
+		assert.Equal(t, tt.valid, ok, tt.raw)
+		assert.Equal(t, tt.want, got, tt.raw)
+	}
+}
+
+func TestYouTubeVideoURLs(t *testing.T) {
+	video := YouTubeVideo{ID: "Wk61XUxP2Mg", StartSeconds: 90}
+	assert.Equal(t, "https://www.youtube.com/watch?v=Wk61XUxP2Mg&t=90s", video.WatchURL())
+	assert.Equal(t, "https://www.youtube.com/embed/Wk61XUxP2Mg?start=90", video.EmbedURL())
+
+	video.StartSeconds = 0
+	assert.Equal(t, "https://www.youtube.com/watch?v=Wk61XUxP2Mg", video.WatchURL())
+	assert.Equal(t, "https://www.youtube.com/embed/Wk61XUxP2Mg", video.EmbedURL())
+}
@@ -14,6 +14,7 @@ import (
 	io "io"
 	reflect "reflect"
 
+	filestorage "github.com/Ari-Pari/backend/internal/clients/filestorage"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,6 +56,21 @@ func (mr *MockFileStorageMockRecorder) DeleteFile(ctx, fileKey any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockFileStorage)(nil).DeleteFile), ctx, fileKey)
 }
 
+// GetDownloadURL mocks base method.
+func (m *MockFileStorage) GetDownloadURL(ctx context.Context, fileKey string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDownloadURL", ctx, fileKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDownloadURL indicates an expected call of GetDownloadURL.
+func (mr *MockFileStorageMockRecorder) GetDownloadURL(ctx, fileKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadURL", reflect.TypeOf((*MockFileStorage)(nil).GetDownloadURL), ctx, fileKey)
+}
+
 // GetFileURL mocks base method.
 func (m *MockFileStorage) GetFileURL(fileKey string) (string, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +101,50 @@ func (mr *MockFileStorageMockRecorder) GetOriginalName(ctx, fileKey any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOriginalName", reflect.TypeOf((*MockFileStorage)(nil).GetOriginalName), ctx, fileKey)
 }
 
+// ListFiles mocks base method.
+func (m *MockFileStorage) ListFiles(ctx context.Context) ([]filestorage.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiles", ctx)
+	ret0, _ := ret[0].([]filestorage.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFiles indicates an expected call of ListFiles.
+func (mr *MockFileStorageMockRecorder) ListFiles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockFileStorage)(nil).ListFiles), ctx)
+}
+
+// OpenFile mocks base method.
+func (m *MockFileStorage) OpenFile(ctx context.Context, fileKey string) (*filestorage.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenFile", ctx, fileKey)
+	ret0, _ := ret[0].(*filestorage.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenFile indicates an expected call of OpenFile.
+func (mr *MockFileStorageMockRecorder) OpenFile(ctx, fileKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenFile", reflect.TypeOf((*MockFileStorage)(nil).OpenFile), ctx, fileKey)
+}
+
+// Ping mocks base method.
+func (m *MockFileStorage) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockFileStorageMockRecorder) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockFileStorage)(nil).Ping), ctx)
+}
+
 // UploadFile mocks base method.
 func (m *MockFileStorage) UploadFile(ctx context.Context, originalName string, reader io.Reader, fileSize int64, contentType string) (string, error) {
 	m.ctrl.T.Helper()
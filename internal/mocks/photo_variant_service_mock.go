@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/services/photoVariantService/service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/services/photoVariantService/service.go -package=mocks -destination=internal/mocks/photo_variant_service_mock.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	image "image"
+	io "io"
+	reflect "reflect"
+
+	domain "github.com/Ari-Pari/backend/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebPEncoder is a mock of WebPEncoder interface.
+type MockWebPEncoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebPEncoderMockRecorder
+	isgomock struct{}
+}
+
+// MockWebPEncoderMockRecorder is the mock recorder for MockWebPEncoder.
+type MockWebPEncoderMockRecorder struct {
+	mock *MockWebPEncoder
+}
+
+// NewMockWebPEncoder creates a new mock instance.
+func NewMockWebPEncoder(ctrl *gomock.Controller) *MockWebPEncoder {
+	mock := &MockWebPEncoder{ctrl: ctrl}
+	mock.recorder = &MockWebPEncoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebPEncoder) EXPECT() *MockWebPEncoderMockRecorder {
+	return m.recorder
+}
+
+// EncodeWebP mocks base method.
+func (m *MockWebPEncoder) EncodeWebP(ctx context.Context, img image.Image) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncodeWebP", ctx, img)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncodeWebP indicates an expected call of EncodeWebP.
+func (mr *MockWebPEncoderMockRecorder) EncodeWebP(ctx, img any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncodeWebP", reflect.TypeOf((*MockWebPEncoder)(nil).EncodeWebP), ctx, img)
+}
+
+// MockPhotoVariantService is a mock of PhotoVariantService interface.
+type MockPhotoVariantService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoVariantServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPhotoVariantServiceMockRecorder is the mock recorder for MockPhotoVariantService.
+type MockPhotoVariantServiceMockRecorder struct {
+	mock *MockPhotoVariantService
+}
+
+// NewMockPhotoVariantService creates a new mock instance.
+func NewMockPhotoVariantService(ctrl *gomock.Controller) *MockPhotoVariantService {
+	mock := &MockPhotoVariantService{ctrl: ctrl}
+	mock.recorder = &MockPhotoVariantServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoVariantService) EXPECT() *MockPhotoVariantServiceMockRecorder {
+	return m.recorder
+}
+
+// UploadPhoto mocks base method.
+func (m *MockPhotoVariantService) UploadPhoto(ctx context.Context, originalName string, reader io.Reader) (string, []domain.PhotoVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPhoto", ctx, originalName, reader)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]domain.PhotoVariant)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UploadPhoto indicates an expected call of UploadPhoto.
+func (mr *MockPhotoVariantServiceMockRecorder) UploadPhoto(ctx, originalName, reader any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPhoto", reflect.TypeOf((*MockPhotoVariantService)(nil).UploadPhoto), ctx, originalName, reader)
+}
@@ -1,16 +1,22 @@
 package parser
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Ari-Pari/backend/internal/clients/filestorage"
 	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/Ari-Pari/backend/internal/services/photoVariantService"
 )
 
 const AudioContentType string = "audio/mpeg"
-const ImageContentType string = "image/jpeg"
 
 func ToDomainRegions(states []StateDto) []domain.Region {
 	regions := make([]domain.Region, len(states))
@@ -20,28 +26,33 @@ func ToDomainRegions(states []StateDto) []domain.Region {
 	return regions
 }
 
-func ToDomainDances(ctx context.Context, storage filestorage.FileStorage, fileReader FileReader, dto []DanceDto, photosFolderName string) ([]domain.DanceShort, error) {
+// ToDomainDances загружает фото танцев в хранилище вместе с уменьшенными копиями. Танцы без фото
+// не прерывают разбор, а попадают в отчёт о медиа; ошибка возвращается только если не сработало само хранилище
+func ToDomainDances(ctx context.Context, photos photoVariantService.PhotoVariantService, fileReader FileReader, dto []DanceDto, photosFolderName string) ([]domain.DanceShort, MediaReport, error) {
 	dances := make([]domain.DanceShort, len(dto))
+	var report MediaReport
 	for i, dance := range dto {
 		var err error
-		dances[i], err = toDomainDance(ctx, storage, fileReader, dance, photosFolderName)
+		dances[i], err = toDomainDance(ctx, photos, fileReader, dance, photosFolderName, &report)
 		if err != nil {
-			return []domain.DanceShort{}, err
+			return []domain.DanceShort{}, report, fmt.Errorf("dance id=%d: %w", dance.Id, err)
 		}
 	}
-	return dances, nil
+	return dances, report, nil
 }
 
-func ToDomainSongs(ctx context.Context, storage filestorage.FileStorage, fileReader FileReader, dto []MusicDto, musicFolderName string) []domain.SongShort {
+// ToDomainSongs загружает аудио песен в хранилище по тем же правилам, что и ToDomainDances
+func ToDomainSongs(ctx context.Context, storage filestorage.FileStorage, source MediaSource, dto []MusicDto) ([]domain.SongShort, MediaReport, error) {
 	songs := make([]domain.SongShort, len(dto))
+	var report MediaReport
 	for i, song := range dto {
 		var err error
-		songs[i], err = toDomainSong(ctx, storage, fileReader, song, musicFolderName)
+		songs[i], err = toDomainSong(ctx, storage, source, song, &report)
 		if err != nil {
-			return []domain.SongShort{}
+			return []domain.SongShort{}, report, fmt.Errorf("song id=%d: %w", song.Id, err)
 		}
 	}
-	return songs
+	return songs, report, nil
 }
 
 func ToDomainVideos(dto []VideoDto) []domain.VideoShort {
@@ -52,6 +63,64 @@ func ToDomainVideos(dto []VideoDto) []domain.VideoShort {
 	return videos
 }
 
+// ToDomainDanceVideos собирает видео из списков urlDanceList, urlLessonList и urlSourceList танцев
+func ToDomainDanceVideos(dto []DanceDto) []domain.VideoShort {
+	var videos []domain.VideoShort
+	for _, dance := range dto {
+		lists := []struct {
+			urls      []string
+			videoType domain.VideoType
+		}{
+			{dance.DanceUrls, domain.Video},
+			{dance.LessonUrls, domain.Lesson},
+			{dance.SourceUrls, domain.Source},
+		}
+		for _, list := range lists {
+			for _, link := range list.urls {
+				if strings.TrimSpace(link) == "" {
+					continue
+				}
+				videos = append(videos, domain.VideoShort{
+					Id:       nil,
+					Name:     toDomainTranslation(dance.Name),
+					NameKey:  dance.Name.ArmName,
+					Link:     link,
+					DanceIds: []int64{dance.Id},
+					Type:     list.videoType,
+				})
+			}
+		}
+	}
+	return videos
+}
+
+// MergeVideos объединяет списки видео без дублей по нормализованной ссылке.
+// Остаются название и тип первого вхождения, танцы всех вхождений объединяются
+func MergeVideos(lists ...[]domain.VideoShort) []domain.VideoShort {
+	var merged []domain.VideoShort
+	indexByURL := make(map[string]int)
+
+	for _, list := range lists {
+		for _, video := range list {
+			key := domain.NormalizeVideoURL(video.Link)
+			i, ok := indexByURL[key]
+			if !ok {
+				video.DanceIds = append([]int64(nil), video.DanceIds...)
+				indexByURL[key] = len(merged)
+				merged = append(merged, video)
+				continue
+			}
+
+			for _, danceId := range video.DanceIds {
+				if !slices.Contains(merged[i].DanceIds, danceId) {
+					merged[i].DanceIds = append(merged[i].DanceIds, danceId)
+				}
+			}
+		}
+	}
+	return merged
+}
+
 func ToDomainArtists(dto []ArtistDto) []domain.ArtistShort {
 	artists := make([]domain.ArtistShort, len(dto))
 	for i, artist := range dto {
@@ -60,6 +129,14 @@ func ToDomainArtists(dto []ArtistDto) []domain.ArtistShort {
 	return artists
 }
 
+func ToDomainGroups(dto []GroupDto) []domain.Group {
+	groups := make([]domain.Group, len(dto))
+	for i, group := range dto {
+		groups[i] = toDomainGroup(group)
+	}
+	return groups
+}
+
 func toDomainArtist(dto ArtistDto) domain.ArtistShort {
 	now := time.Now()
 	deletedAt := &now
@@ -76,6 +153,35 @@ func toDomainArtist(dto ArtistDto) domain.ArtistShort {
 	}
 }
 
+func toDomainGroup(dto GroupDto) domain.Group {
+	return domain.Group{
+		Id:          nil,
+		Name:        toDomainTranslation(dto.Name),
+		NameKey:     dto.Name.ArmName,
+		Flag:        dto.Flag,
+		CountryCode: countryCodeFromFlag(dto.Flag),
+		Url:         dto.Url,
+		Type:        toDomainGroupType(dto.Type),
+	}
+}
+
+// countryCodeFromFlag переводит эмодзи флага (пару regional indicator символов) в ISO 3166-1 alpha-2 код
+func countryCodeFromFlag(flag string) string {
+	runes := []rune(flag)
+	if len(runes) != 2 {
+		return ""
+	}
+
+	code := make([]rune, 2)
+	for i, r := range runes {
+		if r < 0x1F1E6 || r > 0x1F1FF {
+			return ""
+		}
+		code[i] = 'A' + (r - 0x1F1E6)
+	}
+	return string(code)
+}
+
 func toDomainVideo(dto VideoDto) domain.VideoShort {
 	return domain.VideoShort{
 		Id:       nil,
@@ -87,7 +193,7 @@ func toDomainVideo(dto VideoDto) domain.VideoShort {
 	}
 }
 
-func toDomainDance(ctx context.Context, storage filestorage.FileStorage, fileReader FileReader, dto DanceDto, imageFolderName string) (domain.DanceShort, error) {
+func toDomainDance(ctx context.Context, photos photoVariantService.PhotoVariantService, fileReader FileReader, dto DanceDto, imageFolderName string, report *MediaReport) (domain.DanceShort, error) {
 	genres := make([]domain.Genre, len(dto.Genres))
 	holdingTypes := make([]domain.HoldingType, len(dto.HoldingTypes))
 
@@ -106,24 +212,30 @@ func toDomainDance(ctx context.Context, storage filestorage.FileStorage, fileRea
 		holdingTypes[i] = toDomainHoldingType(holdingType)
 	}
 
-	key, err := parseFileForStorage(ctx, storage, fileReader, getImageFileName(imageFolderName, dto.Id), ImageContentType)
+	photo, err := openLocalFile(fileReader, getImageFileName(imageFolderName, dto.Id))
+	key, variants, media, err := uploadPhoto(ctx, photos, photo, err)
 
 	if err != nil {
 		return domain.DanceShort{}, err
 	}
+	if media.problem != nil {
+		report.add(MediaPhoto, dto.Id, media.status, media.problem)
+	}
 
 	return domain.DanceShort{
-		Id:           dto.Id,
-		Name:         toDomainTranslation(dto.Name),
-		NameKey:      dto.NameKey,
-		FileKey:      &key,
-		Complexity:   dto.Difficult,
-		Genres:       genres,
-		Gender:       toDomainGender(dto.Gender),
-		Paces:        dto.Temps,
-		HoldingTypes: holdingTypes,
-		RegionIds:    dto.StateIds,
-		DeletedAt:    deletedAt,
+		Id:            dto.Id,
+		Name:          toDomainTranslation(dto.Name),
+		NameKey:       dto.NameKey,
+		FileKey:       key,
+		Complexity:    dto.Difficult,
+		Genres:        genres,
+		Gender:        toDomainGender(dto.Gender),
+		Paces:         dto.Temps,
+		HoldingTypes:  holdingTypes,
+		RegionIds:     dto.StateIds,
+		DeletedAt:     deletedAt,
+		MediaStatus:   media.status,
+		PhotoVariants: variants,
 	}, nil
 }
 
@@ -131,26 +243,101 @@ func getImageFileName(folderName string, id int64) string {
 	return folderName + strconv.FormatInt(id, 10) + ".jpeg"
 }
 
-func toDomainSong(ctx context.Context, storage filestorage.FileStorage, fileReader FileReader, dto MusicDto, musicFolderName string) (domain.SongShort, error) {
-	fileKey, err := parseFileForStorage(ctx, storage, fileReader, getAudioFileName(musicFolderName, dto.Name.ArmName), AudioContentType)
+func toDomainSong(ctx context.Context, storage filestorage.FileStorage, source MediaSource, dto MusicDto, report *MediaReport) (domain.SongShort, error) {
+	audio, err := source.OpenSong(ctx, dto)
+	fileKey, audioInfo, media, err := uploadAudio(ctx, storage, audio, err)
 
 	if err != nil {
 		return domain.SongShort{}, err
 	}
+	if media.problem != nil {
+		report.add(MediaAudio, dto.Id, media.status, media.problem)
+	}
+
+	lyricsHTML, lyricsText := SanitizeLyrics(dto.Lyrics)
 
 	return domain.SongShort{
-		Id:        dto.Id,
-		Name:      toDomainTranslation(dto.Name),
-		NameKey:   dto.NameKey,
-		FileKey:   &fileKey,
-		DanceIds:  dto.DanceIds,
-		ArtistIds: dto.Artists,
+		Id:          dto.Id,
+		Name:        toDomainTranslation(dto.Name),
+		NameKey:     dto.NameKey,
+		FileKey:     fileKey,
+		DanceIds:    dto.DanceIds,
+		ArtistIds:   dto.Artists,
+		LyricsHTML:  lyricsHTML,
+		LyricsText:  lyricsText,
+		MediaStatus: media.status,
+		Audio:       audioInfo,
 	}, nil
 }
 
 func getAudioFileName(folderName string, name string) string {
 	return folderName + name + ".mp3"
 }
+
+// mediaResult — статус медиа записи и причина, если файл не удалось найти или прочитать
+type mediaResult struct {
+	status  domain.MediaStatus
+	problem error
+}
+
+// uploadAudio загружает открытый MP3 в хранилище и читает из него длительность, битрейт и теги.
+// Ненайденный или нечитаемый файл не считается ошибкой: запись остаётся без ключа, а причина уходит в отчёт.
+// Файл без аудиоданных всё равно загружается, но помечается как битый
+func uploadAudio(ctx context.Context, storage filestorage.FileStorage, file MediaFile, openErr error) (*string, domain.AudioInfo, mediaResult, error) {
+	if media, opened, err := checkOpened(openErr); !opened {
+		return nil, domain.AudioInfo{}, media, err
+	}
+	defer file.Reader.Close()
+
+	// Заголовки разбираются по всему файлу: без Xing длительность считается от размера аудиоданных
+	data, err := io.ReadAll(file.Reader)
+	if err != nil {
+		return nil, domain.AudioInfo{}, mediaResult{status: domain.MediaUnreadable, problem: fmt.Errorf("%w: %s: %v", ErrMediaUnreadable, file.Name, err)}, nil
+	}
+
+	media := mediaResult{status: domain.MediaOk}
+	info, err := ReadMP3Info(data)
+	if err != nil {
+		media = mediaResult{status: domain.MediaCorrupt, problem: fmt.Errorf("%s: %w", file.Name, err)}
+	}
+
+	key, err := storage.UploadFile(ctx, file.Name, bytes.NewReader(data), int64(len(data)), AudioContentType)
+	if err != nil {
+		return nil, domain.AudioInfo{}, mediaResult{}, err
+	}
+	return &key, info, media, nil
+}
+
+// uploadPhoto загружает фото танца вместе с уменьшенными копиями.
+// Файл, который не разбирается как картинка, считается нечитаемым
+func uploadPhoto(ctx context.Context, photos photoVariantService.PhotoVariantService, file MediaFile, openErr error) (*string, []domain.PhotoVariant, mediaResult, error) {
+	if media, opened, err := checkOpened(openErr); !opened {
+		return nil, nil, media, err
+	}
+	defer file.Reader.Close()
+
+	key, variants, err := photos.UploadPhoto(ctx, file.Name, file.Reader)
+	if errors.Is(err, photoVariantService.ErrUndecodable) {
+		return nil, nil, mediaResult{status: domain.MediaUnreadable, problem: fmt.Errorf("%w: %v", ErrMediaUnreadable, err)}, nil
+	}
+	if err != nil {
+		return nil, nil, mediaResult{}, err
+	}
+	return &key, variants, mediaResult{status: domain.MediaOk}, nil
+}
+
+// checkOpened разбирает ошибку открытия файла: opened=false, если загружать нечего
+func checkOpened(openErr error) (mediaResult, bool, error) {
+	switch {
+	case errors.Is(openErr, ErrMediaNotFound):
+		return mediaResult{status: domain.MediaMissing, problem: openErr}, false, nil
+	case errors.Is(openErr, ErrMediaUnreadable):
+		return mediaResult{status: domain.MediaUnreadable, problem: openErr}, false, nil
+	case openErr != nil:
+		return mediaResult{}, false, openErr
+	}
+	return mediaResult{}, true, nil
+}
 func toDomainTranslation(dto NameDto) domain.Translation {
 	return domain.Translation{
 		ArmName: dto.ArmName,
@@ -256,3 +443,18 @@ func toDomainVideoType(dto VideoTypeDto) domain.VideoType {
 		return ""
 	}
 }
+
+func toDomainGroupType(dto GroupTypeDto) domain.GroupType {
+	switch dto {
+	case GroupTypeDiaspora:
+		return domain.Diaspora
+	case GroupTypeYerevan:
+		return domain.Yerevan
+	case GroupTypeState:
+		return domain.State
+	case GroupTypeOrganization:
+		return domain.Organization
+	default:
+		return ""
+	}
+}
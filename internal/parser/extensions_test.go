@@ -44,7 +44,7 @@ func TestToDomainDance_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockStorage := mocks.NewMockFileStorage(ctrl)
+	mockPhotos := mocks.NewMockPhotoVariantService(ctrl)
 
 	difficult := int32(3)
 
@@ -61,17 +61,17 @@ func TestToDomainDance_Success(t *testing.T) {
 		Type:         Active,
 	}
 
-	// Ожидаем вызов UploadFile
-	mockStorage.EXPECT().
-		UploadFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return("mock-image-key", nil).
+	// Ожидаем вызов UploadPhoto
+	mockPhotos.EXPECT().
+		UploadPhoto(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("mock-image-key", nil, nil).
 		Times(1)
 
 	ctx := context.Background()
 	reader := fakeFileReader{}
 	imageFolder := "/images/"
 
-	domainDance, err := toDomainDance(ctx, mockStorage, reader, dto, imageFolder)
+	domainDance, err := toDomainDance(ctx, mockPhotos, reader, dto, imageFolder, &MediaReport{})
 
 	require.NoError(t, err)
 	require.NotNil(t, domainDance)
@@ -89,13 +89,14 @@ func TestToDomainDance_Success(t *testing.T) {
 
 	require.NotNil(t, domainDance.FileKey)
 	assert.Equal(t, "mock-image-key", *domainDance.FileKey)
+	assert.Equal(t, domain.MediaOk, domainDance.MediaStatus)
 }
 
 func TestToDomainDance_UploadFails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockStorage := mocks.NewMockFileStorage(ctrl)
+	mockPhotos := mocks.NewMockPhotoVariantService(ctrl)
 
 	dto := DanceDto{
 		Id:      456,
@@ -108,16 +109,16 @@ func TestToDomainDance_UploadFails(t *testing.T) {
 	}
 
 	// Ожидаем ошибку при загрузке файла
-	mockStorage.EXPECT().
-		UploadFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return("", assert.AnError).
+	mockPhotos.EXPECT().
+		UploadPhoto(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", nil, assert.AnError).
 		Times(1)
 
 	ctx := context.Background()
 	reader := fakeFileReader{}
 	imageFolder := "/images/"
 
-	domainDance, err := toDomainDance(ctx, mockStorage, reader, dto, imageFolder)
+	domainDance, err := toDomainDance(ctx, mockPhotos, reader, dto, imageFolder, &MediaReport{})
 
 	require.Error(t, err)
 	assert.Equal(t, domainDance, domain.DanceShort{})
@@ -138,6 +139,7 @@ func TestToDomainSong_Success(t *testing.T) {
 		Name:     NameDto{ArmName: "Երգ", EngName: "Song", RuName: "Песня"},
 		NameKey:  "song.key",
 		DanceIds: []int64{101, 102},
+		Lyrics:   "<b>Երգ</b>\nտող",
 	}
 
 	// Ожидаем вызов UploadFile
@@ -150,7 +152,7 @@ func TestToDomainSong_Success(t *testing.T) {
 	reader := fakeFileReader{}
 	musicFolder := "/music/"
 
-	domainSong, err := toDomainSong(ctx, mockStorage, reader, dto, musicFolder)
+	domainSong, err := toDomainSong(ctx, mockStorage, NewFolderMediaSource(reader, musicFolder), dto, &MediaReport{})
 
 	require.NoError(t, err)
 	require.NotNil(t, domainSong)
@@ -159,6 +161,8 @@ func TestToDomainSong_Success(t *testing.T) {
 	assert.Equal(t, dto.NameKey, domainSong.NameKey)
 	assert.Equal(t, "Երգ", domainSong.Name.ArmName)
 	assert.Equal(t, dto.DanceIds, domainSong.DanceIds)
+	assert.Equal(t, "<b>Երգ</b>\nտող", domainSong.LyricsHTML)
+	assert.Equal(t, "Երգ\nտող", domainSong.LyricsText)
 
 	require.NotNil(t, domainSong.FileKey)
 	assert.Equal(t, "mock-audio-key", *domainSong.FileKey)
@@ -187,7 +191,7 @@ func TestToDomainSong_UploadFails(t *testing.T) {
 	reader := fakeFileReader{}
 	musicFolder := "/music/"
 
-	domainSong, err := toDomainSong(ctx, mockStorage, reader, dto, musicFolder)
+	domainSong, err := toDomainSong(ctx, mockStorage, NewFolderMediaSource(reader, musicFolder), dto, &MediaReport{})
 
 	require.Error(t, err)
 	assert.Equal(t, domain.SongShort{}, domainSong)
@@ -201,7 +205,7 @@ func TestToDomainDances_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockStorage := mocks.NewMockFileStorage(ctrl)
+	mockPhotos := mocks.NewMockPhotoVariantService(ctrl)
 
 	dtos := []DanceDto{
 		{
@@ -218,24 +222,25 @@ func TestToDomainDances_Success(t *testing.T) {
 		},
 	}
 
-	// Ожидаем два вызова UploadFile
-	mockStorage.EXPECT().
-		UploadFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return("mock-key-1", nil).
+	// Ожидаем два вызова UploadPhoto
+	mockPhotos.EXPECT().
+		UploadPhoto(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("mock-key-1", nil, nil).
 		Times(1)
 
-	mockStorage.EXPECT().
-		UploadFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return("mock-key-2", nil).
+	mockPhotos.EXPECT().
+		UploadPhoto(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("mock-key-2", nil, nil).
 		Times(1)
 
 	ctx := context.Background()
 	reader := fakeFileReader{}
 	imageFolder := "/images/"
 
-	dances, err := ToDomainDances(ctx, mockStorage, reader, dtos, imageFolder)
+	dances, report, err := ToDomainDances(ctx, mockPhotos, reader, dtos, imageFolder)
 
 	require.NoError(t, err)
+	assert.False(t, report.HasProblems())
 	require.Len(t, dances, 2)
 
 	assert.Equal(t, int64(1), dances[0].Id)
@@ -280,18 +285,112 @@ func TestToDomainSongs_Success(t *testing.T) {
 		Times(1)
 
 	ctx := context.Background()
-	reader := fakeFileReader{}
-	musicFolder := "/music/"
+	musicFolder := t.TempDir() + "/"
+	require.NoError(t, os.WriteFile(musicFolder+"Երգ 1.mp3", testMP3(100), 0o644))
+	require.NoError(t, os.WriteFile(musicFolder+"Երգ 2.mp3", testMP3(10), 0o644))
 
-	songs := ToDomainSongs(ctx, mockStorage, reader, dtos, musicFolder)
+	songs, report, err := ToDomainSongs(ctx, mockStorage, NewFolderMediaSource(DefaultFileReader, musicFolder), dtos)
+
+	require.NoError(t, err)
+	assert.False(t, report.HasProblems())
 
 	require.Len(t, songs, 2)
 
 	assert.Equal(t, int64(1), songs[0].Id)
 	assert.Equal(t, "Երգ 1", songs[0].Name.ArmName)
 	assert.Equal(t, "mock-song-key-1", *songs[0].FileKey)
+	assert.Equal(t, domain.MediaOk, songs[0].MediaStatus)
+	assert.Equal(t, 128, songs[0].Audio.BitrateKbps)
+	assert.Equal(t, 44100, songs[0].Audio.SampleRateHz)
+	assert.Positive(t, songs[0].Audio.DurationMs)
 
 	assert.Equal(t, int64(2), songs[1].Id)
 	assert.Equal(t, "Երգ 2", songs[1].Name.ArmName)
 	assert.Equal(t, "mock-song-key-2", *songs[1].FileKey)
 }
+
+// -------------------------------
+// Тесты для ToDomainGroups
+// -------------------------------
+
+func TestToDomainGroups_Success(t *testing.T) {
+	dtos := []GroupDto{
+		{
+			Flag: "🇦🇷",
+			Url:  "https://example.com/ararat",
+			Type: GroupTypeDiaspora,
+			Name: NameDto{ArmName: "Արարատ", EngName: "Ararat", RuName: "Арарат"},
+		},
+		{
+			Flag: "?",
+			Url:  "https://example.com/unknown",
+			Type: "UNKNOWN",
+			Name: NameDto{ArmName: "Անհայտ"},
+		},
+	}
+
+	groups := ToDomainGroups(dtos)
+
+	require.Len(t, groups, 2)
+
+	assert.Nil(t, groups[0].Id)
+	assert.Equal(t, "Արարատ", groups[0].NameKey)
+	assert.Equal(t, "Ararat", groups[0].Name.EngName)
+	assert.Equal(t, "AR", groups[0].CountryCode)
+	assert.Equal(t, domain.Diaspora, groups[0].Type)
+
+	// Неизвестный флаг и тип не роняют импорт, а дают пустые значения
+	assert.Equal(t, "", groups[1].CountryCode)
+	assert.Equal(t, domain.GroupType(""), groups[1].Type)
+}
+
+// -------------------------------
+// Тесты для ToDomainDanceVideos и MergeVideos
+// -------------------------------
+
+func TestToDomainDanceVideos_Success(t *testing.T) {
+	dtos := []DanceDto{
+		{
+			Id:         1,
+			Name:       NameDto{ArmName: "Բերդ", EngName: "Berd"},
+			DanceUrls:  []string{"https://youtu.be/a1"},
+			LessonUrls: []string{"https://youtu.be/b2", ""},
+			SourceUrls: []string{"https://youtu.be/c3"},
+		},
+		{Id: 2},
+	}
+
+	videos := ToDomainDanceVideos(dtos)
+
+	require.Len(t, videos, 3)
+	assert.Equal(t, domain.Video, videos[0].Type)
+	assert.Equal(t, domain.Lesson, videos[1].Type)
+	assert.Equal(t, domain.Source, videos[2].Type)
+	assert.Equal(t, "Բերդ", videos[0].NameKey)
+	assert.Equal(t, "Berd", videos[0].Name.EngName)
+	assert.Equal(t, []int64{1}, videos[2].DanceIds)
+}
+
+func TestMergeVideos_DedupesByNormalizedURL(t *testing.T) {
+	fromVideos := []domain.VideoShort{
+		{NameKey: "Դաս", Link: "https://youtu.be/a1", Type: domain.Lesson, DanceIds: []int64{1}},
+	}
+	fromDances := []domain.VideoShort{
+		{NameKey: "Բերդ", Link: "https://www.youtube.com/watch?v=a1", Type: domain.Video, DanceIds: []int64{1}},
+		{NameKey: "Շալախո", Link: "https://youtu.be/a1?si=x", Type: domain.Video, DanceIds: []int64{2}},
+		{NameKey: "Շալախո", Link: "https://youtu.be/z9", Type: domain.Source, DanceIds: []int64{2}},
+	}
+
+	videos := MergeVideos(fromVideos, fromDances)
+
+	require.Len(t, videos, 2)
+
+	// Название и тип берутся из videos.json, танцы объединяются
+	assert.Equal(t, "Դաս", videos[0].NameKey)
+	assert.Equal(t, domain.Lesson, videos[0].Type)
+	assert.Equal(t, []int64{1, 2}, videos[0].DanceIds)
+	assert.Equal(t, []int64{1}, fromVideos[0].DanceIds)
+
+	assert.Equal(t, "https://youtu.be/z9", videos[1].Link)
+	assert.Equal(t, domain.Source, videos[1].Type)
+}
@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// allowedLyricsTags — безопасное подмножество телеграмной разметки, которое можно отдавать клиенту как есть
+var allowedLyricsTags = map[string]string{
+	"b":      "b",
+	"strong": "b",
+	"i":      "i",
+	"em":     "i",
+	"u":      "u",
+	"ins":    "u",
+	"s":      "s",
+	"strike": "s",
+	"del":    "s",
+}
+
+var lyricsTagPattern = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9-]*)(?:\s[^<>]*)?/?>`)
+
+// SanitizeLyrics возвращает текст песни в двух видах: разметку только из разрешённых тегов
+// (без атрибутов, с закрытыми тегами, остальное экранировано) и чистый текст без тегов
+func SanitizeLyrics(raw string) (markup string, plain string) {
+	raw = strings.TrimSpace(strings.ReplaceAll(raw, "\r\n", "\n"))
+	if raw == "" {
+		return "", ""
+	}
+
+	var out, text strings.Builder
+	var open []string
+
+	writeText := func(s string) {
+		s = html.UnescapeString(s)
+		out.WriteString(html.EscapeString(s))
+		text.WriteString(s)
+	}
+
+	last := 0
+	for _, m := range lyricsTagPattern.FindAllStringSubmatchIndex(raw, -1) {
+		writeText(raw[last:m[0]])
+		last = m[1]
+
+		closing := raw[m[2]:m[3]] == "/"
+		name := strings.ToLower(raw[m[4]:m[5]])
+		if name == "br" {
+			out.WriteString("\n")
+			text.WriteString("\n")
+			continue
+		}
+
+		tag, ok := allowedLyricsTags[name]
+		if !ok {
+			continue
+		}
+
+		if !closing {
+			open = append(open, tag)
+			out.WriteString("<" + tag + ">")
+			continue
+		}
+
+		// закрывающий тег без пары отбрасываем, вложенные незакрытые теги закрываем
+		idx := -1
+		for j := len(open) - 1; j >= 0; j-- {
+			if open[j] == tag {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+		for j := len(open) - 1; j >= idx; j-- {
+			out.WriteString("</" + open[j] + ">")
+		}
+		open = open[:idx]
+	}
+	writeText(raw[last:])
+
+	for j := len(open) - 1; j >= 0; j-- {
+		out.WriteString("</" + open[j] + ">")
+	}
+
+	return out.String(), strings.TrimSpace(text.String())
+}
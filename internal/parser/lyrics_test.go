@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeLyrics(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        string
+		wantMarkup string
+		wantPlain  string
+	}{
+		{
+			name:       "empty",
+			raw:        "  \n ",
+			wantMarkup: "",
+			wantPlain:  "",
+		},
+		{
+			name:       "telegram bold title",
+			raw:        "<b>Ախ, գարուն է</b>\n\nԳարուն է,\r\nգարուն է:",
+			wantMarkup: "<b>Ախ, գարուն է</b>\n\nԳարուն է,\nգարուն է:",
+			wantPlain:  "Ախ, գարուն է\n\nԳարուն է,\nգարուն է:",
+		},
+		{
+			name:       "aliases and attributes are normalized",
+			raw:        `<strong class="x">A</strong> <em>B</em> <del>C</del>`,
+			wantMarkup: "<b>A</b> <i>B</i> <s>C</s>",
+			wantPlain:  "A B C",
+		},
+		{
+			name:       "unsafe tags are dropped",
+			raw:        `<script>alert(1)</script><a href="javascript:x">link</a>`,
+			wantMarkup: "alert(1)link",
+			wantPlain:  "alert(1)link",
+		},
+		{
+			name:       "unbalanced tags are closed",
+			raw:        "<b><i>A</b> B</i> <u>C",
+			wantMarkup: "<b><i>A</i></b> B <u>C</u>",
+			wantPlain:  "A B C",
+		},
+		{
+			name:       "entities are escaped once",
+			raw:        "Tom &amp; Jerry < 3 > 2 <br/>line",
+			wantMarkup: "Tom &amp; Jerry &lt; 3 &gt; 2 \nline",
+			wantPlain:  "Tom & Jerry < 3 > 2 \nline",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			markup, plain := SanitizeLyrics(tt.raw)
+			assert.Equal(t, tt.wantMarkup, markup)
+			assert.Equal(t, tt.wantPlain, plain)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Ari-Pari/backend/internal/domain"
+)
+
+type MediaKind string
+
+const (
+	MediaPhoto MediaKind = "photo"
+	MediaAudio MediaKind = "audio"
+)
+
+// MediaProblem — фото или аудио записи, которое не удалось найти или прочитать
+type MediaProblem struct {
+	Kind     MediaKind          `json:"kind"`
+	RecordID int64              `json:"recordId"`
+	Status   domain.MediaStatus `json:"status"`
+	Reason   string             `json:"reason"`
+}
+
+// MediaReport собирает проблемы с медиа за весь импорт, чтобы записи без файлов не терялись молча
+type MediaReport struct {
+	Problems []MediaProblem `json:"problems"`
+}
+
+func (r *MediaReport) HasProblems() bool {
+	return len(r.Problems) > 0
+}
+
+// Merge добавляет проблемы другого отчёта в конец
+func (r *MediaReport) Merge(other MediaReport) {
+	r.Problems = append(r.Problems, other.Problems...)
+}
+
+func (r *MediaReport) add(kind MediaKind, recordID int64, status domain.MediaStatus, err error) {
+	r.Problems = append(r.Problems, MediaProblem{
+		Kind:     kind,
+		RecordID: recordID,
+		Status:   status,
+		Reason:   strings.ReplaceAll(err.Error(), "\n", "; "),
+	})
+}
+
+func (r *MediaReport) WriteText(w io.Writer) error {
+	for _, p := range r.Problems {
+		if _, err := fmt.Fprintf(w, "%s of record %d: %s: %s\n", p.Kind, p.RecordID, p.Status, p.Reason); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d media problem(s) found\n", len(r.Problems))
+	return err
+}
+
+func (r *MediaReport) WriteJSON(w io.Writer) error {
+	if r.Problems == nil {
+		r.Problems = []MediaProblem{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
@@ -0,0 +1,122 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/Ari-Pari/backend/internal/mocks"
+	"github.com/Ari-Pari/backend/internal/services/photoVariantService"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestToDomainDances_ReportsMissingAndUnreadablePhotos(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir() + "/"
+	require.NoError(t, os.WriteFile(dir+"1.jpeg", []byte("jpeg"), 0o644))
+	require.NoError(t, os.WriteFile(dir+"3.jpeg", nil, 0o644))
+	require.NoError(t, os.WriteFile(dir+"4.jpeg", []byte("not an image"), 0o644))
+
+	variants := []domain.PhotoVariant{{Size: "thumb", Format: "jpeg", Width: 320, Height: 240, Key: "photo-1-thumb"}}
+	mockPhotos := mocks.NewMockPhotoVariantService(ctrl)
+	mockPhotos.EXPECT().
+		UploadPhoto(gomock.Any(), dir+"1.jpeg", gomock.Any()).
+		Return("photo-1", variants, nil).
+		Times(1)
+	mockPhotos.EXPECT().
+		UploadPhoto(gomock.Any(), dir+"4.jpeg", gomock.Any()).
+		Return("", nil, fmt.Errorf("%w: %s", photoVariantService.ErrUndecodable, dir+"4.jpeg")).
+		Times(1)
+
+	dtos := []DanceDto{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}}
+	dances, report, err := ToDomainDances(context.Background(), mockPhotos, DefaultFileReader, dtos, dir)
+
+	require.NoError(t, err)
+	require.Len(t, dances, 4)
+
+	require.NotNil(t, dances[0].FileKey)
+	assert.Equal(t, "photo-1", *dances[0].FileKey)
+	assert.Equal(t, variants, dances[0].PhotoVariants)
+	assert.Equal(t, domain.MediaOk, dances[0].MediaStatus)
+
+	assert.Nil(t, dances[1].FileKey)
+	assert.Equal(t, domain.MediaMissing, dances[1].MediaStatus)
+
+	assert.Nil(t, dances[2].FileKey)
+	assert.Equal(t, domain.MediaUnreadable, dances[2].MediaStatus)
+
+	assert.Nil(t, dances[3].FileKey)
+	assert.Empty(t, dances[3].PhotoVariants)
+	assert.Equal(t, domain.MediaUnreadable, dances[3].MediaStatus)
+
+	require.Len(t, report.Problems, 3)
+	assert.Equal(t, MediaProblem{Kind: MediaPhoto, RecordID: 2, Status: domain.MediaMissing, Reason: report.Problems[0].Reason}, report.Problems[0])
+	assert.Equal(t, MediaProblem{Kind: MediaPhoto, RecordID: 3, Status: domain.MediaUnreadable, Reason: "media unreadable: " + dir + "3.jpeg is empty"}, report.Problems[1])
+	assert.Equal(t, MediaProblem{Kind: MediaPhoto, RecordID: 4, Status: domain.MediaUnreadable, Reason: "media unreadable: image is not decodable: " + dir + "4.jpeg"}, report.Problems[2])
+}
+
+func TestToDomainSongs_UploadErrorStopsImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockFileStorage(ctrl)
+	mockStorage.EXPECT().
+		UploadFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", assert.AnError).
+		Times(1)
+
+	dtos := []MusicDto{{Id: 5, Name: NameDto{ArmName: "Երգ"}}, {Id: 6}}
+	songs, _, err := ToDomainSongs(context.Background(), mockStorage, NewFolderMediaSource(fakeFileReader{}, "/music/"), dtos)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "song id=5")
+	assert.Empty(t, songs)
+}
+
+func TestToDomainSongs_ReportsCorruptAudio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir() + "/"
+	require.NoError(t, os.WriteFile(dir+"Երգ.mp3", []byte("not an mp3"), 0o644))
+
+	// Битый файл всё равно загружается целиком, чтобы его можно было скачать и проверить
+	mockStorage := mocks.NewMockFileStorage(ctrl)
+	mockStorage.EXPECT().
+		UploadFile(gomock.Any(), dir+"Երգ.mp3", gomock.Any(), int64(len("not an mp3")), AudioContentType).
+		Return("corrupt-key", nil).
+		Times(1)
+
+	dtos := []MusicDto{{Id: 9, Name: NameDto{ArmName: "Երգ"}}}
+	songs, report, err := ToDomainSongs(context.Background(), mockStorage, NewFolderMediaSource(DefaultFileReader, dir), dtos)
+
+	require.NoError(t, err)
+	require.Len(t, songs, 1)
+	require.NotNil(t, songs[0].FileKey)
+	assert.Equal(t, "corrupt-key", *songs[0].FileKey)
+	assert.Equal(t, domain.MediaCorrupt, songs[0].MediaStatus)
+	assert.Equal(t, domain.AudioInfo{}, songs[0].Audio)
+
+	require.Len(t, report.Problems, 1)
+	assert.Equal(t, MediaProblem{Kind: MediaAudio, RecordID: 9, Status: domain.MediaCorrupt, Reason: dir + "Երգ.mp3: media corrupt: no MPEG audio frames found"}, report.Problems[0])
+}
+
+func TestMediaReport_Write(t *testing.T) {
+	report := MediaReport{}
+	report.Merge(MediaReport{Problems: []MediaProblem{{Kind: MediaAudio, RecordID: 4, Status: domain.MediaMissing, Reason: "media not found"}}})
+
+	var text bytes.Buffer
+	require.NoError(t, report.WriteText(&text))
+	assert.Equal(t, "audio of record 4: MISSING: media not found\n1 media problem(s) found\n", text.String())
+
+	var jsonOut bytes.Buffer
+	require.NoError(t, report.WriteJSON(&jsonOut))
+	assert.JSONEq(t, `{"problems":[{"kind":"audio","recordId":4,"status":"MISSING","reason":"media not found"}]}`, jsonOut.String())
+}
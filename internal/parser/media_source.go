@@ -0,0 +1,211 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrMediaNotFound — источник не знает файла для записи, можно попробовать следующий
+	ErrMediaNotFound = errors.New("media not found")
+	// ErrMediaUnreadable — файл есть, но пустой или не читается
+	ErrMediaUnreadable = errors.New("media unreadable")
+	// ErrMediaCorrupt — файл читается, но в нём не нашлось ни одного корректного MPEG-кадра
+	ErrMediaCorrupt = errors.New("media corrupt")
+)
+
+// MediaFile — найденный файл, готовый к загрузке в хранилище.
+// Size равен -1, если размер заранее неизвестен
+type MediaFile struct {
+	Name   string
+	Reader io.ReadCloser
+	Size   int64
+}
+
+// MediaSource ищет аудио песни по записи из musics.json
+type MediaSource interface {
+	OpenSong(ctx context.Context, dto MusicDto) (MediaFile, error)
+}
+
+// folderMediaSource ищет "<папка><армянское название>.mp3"
+type folderMediaSource struct {
+	fileReader FileReader
+	folder     string
+}
+
+func NewFolderMediaSource(fileReader FileReader, folder string) MediaSource {
+	return folderMediaSource{fileReader: fileReader, folder: folder}
+}
+
+func (s folderMediaSource) OpenSong(_ context.Context, dto MusicDto) (MediaFile, error) {
+	return openLocalFile(s.fileReader, getAudioFileName(s.folder, dto.Name.ArmName))
+}
+
+// fileUniqueIDMediaSource ищет "<папка><fileUniqueId>.mp3" — так файлы лежат после выгрузки из Telegram
+type fileUniqueIDMediaSource struct {
+	fileReader FileReader
+	folder     string
+}
+
+func NewFileUniqueIDMediaSource(fileReader FileReader, folder string) MediaSource {
+	return fileUniqueIDMediaSource{fileReader: fileReader, folder: folder}
+}
+
+func (s fileUniqueIDMediaSource) OpenSong(_ context.Context, dto MusicDto) (MediaFile, error) {
+	if dto.FileUniqueId == "" {
+		return MediaFile{}, ErrMediaNotFound
+	}
+	return openLocalFile(s.fileReader, getAudioFileName(s.folder, dto.FileUniqueId))
+}
+
+func openLocalFile(fileReader FileReader, filename string) (MediaFile, error) {
+	file, err := fileReader.Open(filename)
+	if err != nil {
+		return MediaFile{}, fmt.Errorf("%w: %v", ErrMediaNotFound, err)
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return MediaFile{}, fmt.Errorf("%w: stat %s: %v", ErrMediaUnreadable, filename, err)
+	}
+	if fileInfo.Size() == 0 {
+		_ = file.Close()
+		return MediaFile{}, fmt.Errorf("%w: %s is empty", ErrMediaUnreadable, filename)
+	}
+
+	return MediaFile{Name: filename, Reader: file, Size: fileInfo.Size()}, nil
+}
+
+// telegramMediaSource скачивает файл по fileId через Bot API: getFile отдаёт file_path,
+// а сам файл лежит по адресу <apiURL>/file/bot<token>/<file_path>
+type telegramMediaSource struct {
+	client *http.Client
+	apiURL string
+	token  string
+}
+
+// NewTelegramMediaSource принимает адрес Bot API (https://api.telegram.org или совместимый сервер) и токен бота
+func NewTelegramMediaSource(client *http.Client, apiURL, token string) MediaSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return telegramMediaSource{client: client, apiURL: strings.TrimSuffix(apiURL, "/"), token: token}
+}
+
+type telegramResponse struct {
+	Ok          bool   `json:"ok"`
+	Description string `json:"description"`
+	Result      struct {
+		FilePath string `json:"file_path"`
+	} `json:"result"`
+}
+
+func (s telegramMediaSource) OpenSong(ctx context.Context, dto MusicDto) (MediaFile, error) {
+	if dto.FileId == "" {
+		return MediaFile{}, ErrMediaNotFound
+	}
+
+	filePath, err := s.getFilePath(ctx, dto.FileId)
+	if err != nil {
+		return MediaFile{}, err
+	}
+
+	resp, err := s.get(ctx, s.apiURL+"/file/bot"+s.token+"/"+filePath)
+	if err != nil {
+		return MediaFile{}, fmt.Errorf("download telegram file %s: %w", dto.FileId, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return MediaFile{}, fmt.Errorf("%w: telegram file %s", ErrMediaNotFound, dto.FileId)
+		}
+		return MediaFile{}, fmt.Errorf("download telegram file %s: unexpected status %s", dto.FileId, resp.Status)
+	}
+
+	return MediaFile{
+		Name:   getAudioFileName("", dto.Name.ArmName),
+		Reader: resp.Body,
+		Size:   resp.ContentLength,
+	}, nil
+}
+
+func (s telegramMediaSource) getFilePath(ctx context.Context, fileID string) (string, error) {
+	endpoint := s.apiURL + "/bot" + s.token + "/getFile?" + url.Values{"file_id": {fileID}}.Encode()
+	resp, err := s.get(ctx, endpoint)
+	if err != nil {
+		return "", fmt.Errorf("telegram getFile %s: %w", fileID, err)
+	}
+	defer resp.Body.Close()
+
+	var body telegramResponse
+	decodeErr := json.NewDecoder(resp.Body).Decode(&body)
+
+	// Неизвестный или чужой file_id Bot API возвращает как 400 Bad Request
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("%w: telegram getFile %s: %s", ErrMediaNotFound, fileID, body.Description)
+	}
+	if decodeErr != nil {
+		return "", fmt.Errorf("telegram getFile %s: decode response: %w", fileID, decodeErr)
+	}
+	if !body.Ok || body.Result.FilePath == "" {
+		return "", fmt.Errorf("telegram getFile %s: %s (status %s)", fileID, body.Description, resp.Status)
+	}
+
+	return body.Result.FilePath, nil
+}
+
+// get выполняет GET-запрос к Bot API. Токен входит в путь запроса, а *url.Error печатает адрес целиком,
+// поэтому ошибка возвращается без адреса и без токена — она попадает в лог и отчёт о медиа
+func (s telegramMediaSource) get(ctx context.Context, endpoint string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, s.hideToken(err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, s.hideToken(err)
+	}
+	return resp, nil
+}
+
+func (s telegramMediaSource) hideToken(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = fmt.Errorf("%s: %w", urlErr.Op, urlErr.Err)
+	}
+	if s.token != "" && strings.Contains(err.Error(), s.token) {
+		return errors.New(strings.ReplaceAll(err.Error(), s.token, "<token>"))
+	}
+	return err
+}
+
+// chainMediaSource опрашивает источники по порядку, пока один из них не найдёт файл.
+// Если не нашёл никто, причины всех источников объединяются для отчёта
+type chainMediaSource []MediaSource
+
+func NewChainMediaSource(sources ...MediaSource) MediaSource {
+	return chainMediaSource(sources)
+}
+
+func (c chainMediaSource) OpenSong(ctx context.Context, dto MusicDto) (MediaFile, error) {
+	var notFound []error
+	for _, source := range c {
+		file, err := source.OpenSong(ctx, dto)
+		if errors.Is(err, ErrMediaNotFound) {
+			notFound = append(notFound, err)
+			continue
+		}
+		return file, err
+	}
+	if len(notFound) == 0 {
+		return MediaFile{}, ErrMediaNotFound
+	}
+	return MediaFile{}, errors.Join(notFound...)
+}
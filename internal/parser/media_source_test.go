@@ -0,0 +1,215 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/Ari-Pari/backend/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testBotToken = "123:test"
+
+// newTelegramStub поднимает локальный сервер с методом getFile и раздачей файлов как у Bot API
+func newTelegramStub(t *testing.T, files map[string]string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/bot"+testBotToken+"/getFile", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fileID := r.URL.Query().Get("file_id")
+		if _, ok := files[fileID]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"ok":false,"error_code":400,"description":"Bad Request: invalid file_id"}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"ok":true,"result":{"file_id":"`+fileID+`","file_path":"music/`+fileID+`.mp3"}}`)
+	})
+	mux.HandleFunc("/file/bot"+testBotToken+"/music/", func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[strings.TrimSuffix(path.Base(r.URL.Path), ".mp3")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, content)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func readMediaFile(t *testing.T, file MediaFile) string {
+	t.Helper()
+	defer file.Reader.Close()
+	data, err := io.ReadAll(file.Reader)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestTelegramMediaSource_Success(t *testing.T) {
+	server := newTelegramStub(t, map[string]string{"CQACAgI": "mp3-bytes"})
+	source := NewTelegramMediaSource(server.Client(), server.URL+"/", testBotToken)
+
+	file, err := source.OpenSong(context.Background(), MusicDto{Name: NameDto{ArmName: "Երգ"}, FileId: "CQACAgI"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Երգ.mp3", file.Name)
+	assert.Equal(t, int64(len("mp3-bytes")), file.Size)
+	assert.Equal(t, "mp3-bytes", readMediaFile(t, file))
+}
+
+func TestTelegramMediaSource_UnknownFileID(t *testing.T) {
+	server := newTelegramStub(t, map[string]string{})
+	source := NewTelegramMediaSource(server.Client(), server.URL, testBotToken)
+
+	_, err := source.OpenSong(context.Background(), MusicDto{FileId: "missing"})
+
+	require.ErrorIs(t, err, ErrMediaNotFound)
+	assert.Contains(t, err.Error(), "invalid file_id")
+}
+
+func TestTelegramMediaSource_NoFileID(t *testing.T) {
+	source := NewTelegramMediaSource(nil, "http://127.0.0.1:0", testBotToken)
+
+	_, err := source.OpenSong(context.Background(), MusicDto{})
+
+	require.ErrorIs(t, err, ErrMediaNotFound)
+}
+
+func TestTelegramMediaSource_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, `{"ok":false,"description":"Internal Server Error"}`)
+	}))
+	t.Cleanup(server.Close)
+	source := NewTelegramMediaSource(server.Client(), server.URL, testBotToken)
+
+	_, err := source.OpenSong(context.Background(), MusicDto{FileId: "CQACAgI"})
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrMediaNotFound)
+}
+
+// failingTransport отвечает на любой запрос ошибкой соединения
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestTelegramMediaSource_TransportErrorHidesToken(t *testing.T) {
+	source := NewTelegramMediaSource(&http.Client{Transport: failingTransport{}}, "https://api.telegram.org", testBotToken)
+
+	_, err := source.OpenSong(context.Background(), MusicDto{FileId: "CQACAgI"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection refused")
+	assert.NotContains(t, err.Error(), testBotToken)
+}
+
+func TestTelegramMediaSource_DownloadErrorHidesToken(t *testing.T) {
+	server := newTelegramStub(t, map[string]string{"CQACAgI": "mp3-bytes"})
+	transport := server.Client().Transport
+	// getFile отвечает, а скачивание самого файла обрывается
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if strings.Contains(req.URL.Path, "/file/") {
+			return nil, errors.New("connection reset")
+		}
+		return transport.RoundTrip(req)
+	})}
+	source := NewTelegramMediaSource(client, server.URL, testBotToken)
+
+	_, err := source.OpenSong(context.Background(), MusicDto{FileId: "CQACAgI"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection reset")
+	assert.NotContains(t, err.Error(), testBotToken)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestFileUniqueIDMediaSource(t *testing.T) {
+	dir := t.TempDir() + "/"
+	require.NoError(t, os.WriteFile(dir+"AgADtHoAAhSEaUg.mp3", []byte("by-unique-id"), 0o644))
+	source := NewFileUniqueIDMediaSource(DefaultFileReader, dir)
+
+	file, err := source.OpenSong(context.Background(), MusicDto{FileUniqueId: "AgADtHoAAhSEaUg"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("by-unique-id")), file.Size)
+	assert.Equal(t, "by-unique-id", readMediaFile(t, file))
+
+	_, err = source.OpenSong(context.Background(), MusicDto{FileUniqueId: "other"})
+	require.ErrorIs(t, err, ErrMediaNotFound)
+}
+
+func TestChainMediaSource_FallsBackToTelegram(t *testing.T) {
+	server := newTelegramStub(t, map[string]string{"CQACAgI": "from-telegram"})
+	source := NewChainMediaSource(
+		NewFolderMediaSource(DefaultFileReader, t.TempDir()+"/"),
+		NewFileUniqueIDMediaSource(DefaultFileReader, t.TempDir()+"/"),
+		NewTelegramMediaSource(server.Client(), server.URL, testBotToken),
+	)
+
+	file, err := source.OpenSong(context.Background(), MusicDto{Name: NameDto{ArmName: "Երգ"}, FileId: "CQACAgI", FileUniqueId: "AgAD"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "from-telegram", readMediaFile(t, file))
+}
+
+func TestToDomainSong_UploadsTelegramAudio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTelegramStub(t, map[string]string{"CQACAgI": "mp3-bytes"})
+	mockStorage := mocks.NewMockFileStorage(ctrl)
+
+	mockStorage.EXPECT().
+		UploadFile(gomock.Any(), "Երգ.mp3", gomock.Any(), int64(len("mp3-bytes")), AudioContentType).
+		DoAndReturn(func(_ context.Context, _ string, reader io.Reader, _ int64, _ string) (string, error) {
+			data, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, "mp3-bytes", string(data))
+			return "audio-key", nil
+		})
+
+	dto := MusicDto{Id: 1, Name: NameDto{ArmName: "Երգ"}, FileId: "CQACAgI"}
+	song, err := toDomainSong(context.Background(), mockStorage, NewTelegramMediaSource(server.Client(), server.URL, testBotToken), dto, &MediaReport{})
+
+	require.NoError(t, err)
+	require.NotNil(t, song.FileKey)
+	assert.Equal(t, "audio-key", *song.FileKey)
+}
+
+func TestToDomainSong_MediaNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Хранилище не должно вызываться, если аудио не нашлось
+	mockStorage := mocks.NewMockFileStorage(ctrl)
+	var report MediaReport
+
+	source := NewChainMediaSource(NewFolderMediaSource(DefaultFileReader, t.TempDir()+"/"))
+	song, err := toDomainSong(context.Background(), mockStorage, source, MusicDto{Id: 7, Name: NameDto{ArmName: "Երգ"}}, &report)
+
+	require.NoError(t, err)
+	assert.Nil(t, song.FileKey)
+	assert.Equal(t, domain.MediaMissing, song.MediaStatus)
+	require.Len(t, report.Problems, 1)
+	assert.Equal(t, MediaAudio, report.Problems[0].Kind)
+	assert.Equal(t, int64(7), report.Problems[0].RecordID)
+	assert.Equal(t, domain.MediaMissing, report.Problems[0].Status)
+	assert.Contains(t, report.Problems[0].Reason, "Երգ.mp3")
+}
@@ -38,6 +38,7 @@ type TypeDto string
 const (
 	Active TypeDto = "ACTIVE"
 	Extra  TypeDto = "EXTRA"
+	Main   TypeDto = "MAIN" // основная песня танца в musics.json
 )
 
 type GenreDto string
@@ -73,15 +74,21 @@ type DanceDto struct {
 	Difficult    *int32           `json:"difficult"`
 	Genres       []GenreDto       `json:"genres"`
 	StateIds     []int64          `json:"states"`
+	DanceUrls    []string         `json:"urlDanceList"`
+	LessonUrls   []string         `json:"urlLessonList"`
+	SourceUrls   []string         `json:"urlSourceList"`
 }
 
 type MusicDto struct {
-	Id       int64   `json:"id"`
-	Name     NameDto `json:"name"`
-	NameKey  string  `json:"nameKey"`
-	DanceIds []int64 `json:"danceIds"`
-	Type     TypeDto `json:"type"`
-	Artists  []int64 `json:"groupIds"`
+	Id           int64   `json:"id"`
+	Name         NameDto `json:"name"`
+	FileId       string  `json:"fileId"`       // id аудио в Telegram, по нему файл скачивается через Bot API
+	FileUniqueId string  `json:"fileUniqueId"` // постоянный id файла, одинаковый для всех ботов
+	NameKey      string  `json:"nameKey"`
+	DanceIds     []int64 `json:"danceIds"`
+	Type         TypeDto `json:"type"`
+	Artists      []int64 `json:"groupIds"`
+	Lyrics       string  `json:"lyrics"`
 }
 
 type VideoTypeDto string
@@ -105,3 +112,19 @@ type ArtistDto struct {
 	Type TypeDto `json:"type"`
 	Url  string  `json:"urlInsta"`
 }
+
+type GroupTypeDto string
+
+const (
+	GroupTypeDiaspora     GroupTypeDto = "DIASPORA"
+	GroupTypeYerevan      GroupTypeDto = "YEREVAN"
+	GroupTypeState        GroupTypeDto = "STATE"
+	GroupTypeOrganization GroupTypeDto = "ORGANIZATION"
+)
+
+type GroupDto struct {
+	Flag string       `json:"flag"`
+	Url  string       `json:"url"`
+	Type GroupTypeDto `json:"type"`
+	Name NameDto      `json:"name"`
+}
@@ -0,0 +1,344 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/Ari-Pari/backend/internal/domain"
+)
+
+const (
+	mpeg25 = 0
+	mpeg2  = 2
+	mpeg1  = 3
+
+	layer3 = 1
+	layer2 = 2
+	layer1 = 3
+
+	id3v1Size = 128
+)
+
+// Битрейты в кбит/с по индексу из заголовка кадра
+var (
+	bitratesV1L1  = [16]int{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, -1}
+	bitratesV1L2  = [16]int{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, -1}
+	bitratesV1L3  = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, -1}
+	bitratesV2L1  = [16]int{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, -1}
+	bitratesV2L23 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, -1}
+)
+
+var sampleRates = map[int][3]int{
+	mpeg1:  {44100, 48000, 32000},
+	mpeg2:  {22050, 24000, 16000},
+	mpeg25: {11025, 12000, 8000},
+}
+
+// frameHeader — разобранные 4 байта заголовка MPEG-кадра
+type frameHeader struct {
+	version     int
+	layer       int
+	bitrateKbps int
+	sampleRate  int
+	padding     int
+	mono        bool
+}
+
+func parseFrameHeader(b []byte) (frameHeader, bool) {
+	if len(b) < 4 || b[0] != 0xFF || b[1]&0xE0 != 0xE0 {
+		return frameHeader{}, false
+	}
+	h := frameHeader{
+		version: int(b[1]>>3) & 3,
+		layer:   int(b[1]>>1) & 3,
+		padding: int(b[2]>>1) & 1,
+		mono:    b[3]>>6 == 3,
+	}
+	if h.version == 1 || h.layer == 0 {
+		return frameHeader{}, false
+	}
+
+	bitrateIndex := int(b[2] >> 4)
+	sampleRateIndex := int(b[2]>>2) & 3
+	// Свободный битрейт (индекс 0) не даёт посчитать длину кадра, такие файлы не поддерживаем
+	if bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		return frameHeader{}, false
+	}
+
+	switch {
+	case h.version == mpeg1 && h.layer == layer1:
+		h.bitrateKbps = bitratesV1L1[bitrateIndex]
+	case h.version == mpeg1 && h.layer == layer2:
+		h.bitrateKbps = bitratesV1L2[bitrateIndex]
+	case h.version == mpeg1:
+		h.bitrateKbps = bitratesV1L3[bitrateIndex]
+	case h.layer == layer1:
+		h.bitrateKbps = bitratesV2L1[bitrateIndex]
+	default:
+		h.bitrateKbps = bitratesV2L23[bitrateIndex]
+	}
+	h.sampleRate = sampleRates[h.version][sampleRateIndex]
+	return h, true
+}
+
+func (h frameHeader) samplesPerFrame() int {
+	switch {
+	case h.layer == layer1:
+		return 384
+	case h.layer == layer3 && h.version != mpeg1:
+		return 576
+	default:
+		return 1152
+	}
+}
+
+func (h frameHeader) frameLength() int {
+	bitrate := h.bitrateKbps * 1000
+	if h.layer == layer1 {
+		return (12*bitrate/h.sampleRate + h.padding) * 4
+	}
+	return h.samplesPerFrame()/8*bitrate/h.sampleRate + h.padding
+}
+
+// sideInfoSize — размер служебных данных Layer III, после которых в первом кадре лежит заголовок Xing
+func (h frameHeader) sideInfoSize() int {
+	switch {
+	case h.version == mpeg1 && h.mono:
+		return 17
+	case h.version == mpeg1:
+		return 32
+	case h.mono:
+		return 9
+	default:
+		return 17
+	}
+}
+
+// ReadMP3Info разбирает ID3-теги и заголовки MPEG-кадров. Длительность берётся из заголовка Xing/Info
+// или VBRI, если он есть, а для файлов с постоянным битрейтом считается по размеру аудиоданных
+func ReadMP3Info(data []byte) (domain.AudioInfo, error) {
+	var info domain.AudioInfo
+
+	audioStart, title, artist := readID3v2(data)
+	audioEnd := len(data)
+	if v1Title, v1Artist, ok := readID3v1(data); ok {
+		audioEnd -= id3v1Size
+		if title == "" {
+			title = v1Title
+		}
+		if artist == "" {
+			artist = v1Artist
+		}
+	}
+	info.Title, info.Artist = title, artist
+
+	if audioStart > audioEnd {
+		return info, fmt.Errorf("%w: ID3 tag is longer than the file", ErrMediaCorrupt)
+	}
+	offset, header, ok := findFirstFrame(data[audioStart:audioEnd])
+	if !ok {
+		return info, fmt.Errorf("%w: no MPEG audio frames found", ErrMediaCorrupt)
+	}
+	frameStart := audioStart + offset
+	audioBytes := int64(audioEnd - frameStart)
+
+	info.SampleRateHz = header.sampleRate
+	frames, vbrBytes := readVBRHeader(data[frameStart:audioEnd], header)
+	if frames > 0 {
+		if vbrBytes > 0 {
+			audioBytes = vbrBytes
+		}
+		samples := int64(frames) * int64(header.samplesPerFrame())
+		info.DurationMs = int(samples * 1000 / int64(header.sampleRate))
+		if info.DurationMs > 0 {
+			info.BitrateKbps = int(audioBytes * 8 / int64(info.DurationMs))
+		}
+		return info, nil
+	}
+
+	info.BitrateKbps = header.bitrateKbps
+	info.DurationMs = int(audioBytes * 8 / int64(header.bitrateKbps))
+	return info, nil
+}
+
+// findFirstFrame ищет заголовок кадра, за которым сразу идёт ещё один такой же: одиночные байты 0xFF
+// внутри мусора перед аудио часто похожи на заголовок
+func findFirstFrame(data []byte) (int, frameHeader, bool) {
+	for i := 0; i+4 <= len(data); i++ {
+		header, ok := parseFrameHeader(data[i:])
+		if !ok {
+			continue
+		}
+		next := i + header.frameLength()
+		if next == len(data) {
+			return i, header, true
+		}
+		nextHeader, ok := parseFrameHeader(data[min(next, len(data)):])
+		if ok && nextHeader.version == header.version && nextHeader.layer == header.layer && nextHeader.sampleRate == header.sampleRate {
+			return i, header, true
+		}
+	}
+	return 0, frameHeader{}, false
+}
+
+// readVBRHeader читает число кадров и байт из заголовка Xing/Info или VBRI в первом кадре
+func readVBRHeader(frame []byte, header frameHeader) (frames uint32, audioBytes int64) {
+	if header.layer != layer3 {
+		return 0, 0
+	}
+
+	xing := 4 + header.sideInfoSize()
+	if len(frame) >= xing+16 && (bytes.Equal(frame[xing:xing+4], []byte("Xing")) || bytes.Equal(frame[xing:xing+4], []byte("Info"))) {
+		flags := binary.BigEndian.Uint32(frame[xing+4:])
+		pos := xing + 8
+		if flags&1 != 0 {
+			frames = binary.BigEndian.Uint32(frame[pos:])
+			pos += 4
+		}
+		if flags&2 != 0 && len(frame) >= pos+4 {
+			audioBytes = int64(binary.BigEndian.Uint32(frame[pos:]))
+		}
+		return frames, audioBytes
+	}
+
+	const vbri = 4 + 32
+	if len(frame) >= vbri+18 && bytes.Equal(frame[vbri:vbri+4], []byte("VBRI")) {
+		audioBytes = int64(binary.BigEndian.Uint32(frame[vbri+10:]))
+		frames = binary.BigEndian.Uint32(frame[vbri+14:])
+		return frames, audioBytes
+	}
+	return 0, 0
+}
+
+// readID3v2 возвращает размер тега ID3v2 в начале файла, название (TIT2) и исполнителя (TPE1)
+func readID3v2(data []byte) (int, string, string) {
+	if len(data) < 10 || !bytes.Equal(data[:3], []byte("ID3")) {
+		return 0, "", ""
+	}
+	major := data[3]
+	flags := data[5]
+	size := 10 + syncsafe(data[6:10])
+	if flags&0x10 != 0 {
+		size += 10 // футер ID3v2.4
+	}
+	end := min(10+syncsafe(data[6:10]), len(data))
+
+	pos := 10
+	if flags&0x40 != 0 && major >= 3 && pos+4 <= end {
+		if major == 3 {
+			pos += 4 + int(binary.BigEndian.Uint32(data[pos:]))
+		} else {
+			pos += syncsafe(data[pos : pos+4])
+		}
+	}
+
+	idLen, headerLen := 4, 10
+	titleID, artistID := "TIT2", "TPE1"
+	if major == 2 {
+		idLen, headerLen = 3, 6
+		titleID, artistID = "TT2", "TP1"
+	}
+
+	var title, artist string
+	for pos+headerLen <= end && data[pos] != 0 {
+		id := string(data[pos : pos+idLen])
+		var frameSize int
+		switch major {
+		case 2:
+			frameSize = int(data[pos+3])<<16 | int(data[pos+4])<<8 | int(data[pos+5])
+		case 3:
+			frameSize = int(binary.BigEndian.Uint32(data[pos+4:]))
+		default:
+			frameSize = syncsafe(data[pos+4 : pos+8])
+		}
+		body := pos + headerLen
+		if frameSize <= 0 || body+frameSize > end {
+			break
+		}
+		switch id {
+		case titleID:
+			title = decodeID3Text(data[body : body+frameSize])
+		case artistID:
+			artist = decodeID3Text(data[body : body+frameSize])
+		}
+		pos = body + frameSize
+	}
+	return size, title, artist
+}
+
+// readID3v1 читает 128-байтный тег в конце файла
+func readID3v1(data []byte) (string, string, bool) {
+	if len(data) < id3v1Size {
+		return "", "", false
+	}
+	tag := data[len(data)-id3v1Size:]
+	if !bytes.Equal(tag[:3], []byte("TAG")) {
+		return "", "", false
+	}
+	return cleanTagText(decodeLatin1(tag[3:33])), cleanTagText(decodeLatin1(tag[33:63])), true
+}
+
+func syncsafe(b []byte) int {
+	return int(b[0]&0x7F)<<21 | int(b[1]&0x7F)<<14 | int(b[2]&0x7F)<<7 | int(b[3]&0x7F)
+}
+
+// decodeID3Text декодирует текстовый кадр: первый байт — кодировка, несколько значений разделены нулём
+func decodeID3Text(frame []byte) string {
+	if len(frame) == 0 {
+		return ""
+	}
+	var text string
+	switch body := frame[1:]; frame[0] {
+	case 1:
+		text = decodeUTF16(body, nil)
+	case 2:
+		text = decodeUTF16(body, binary.BigEndian)
+	case 3:
+		text = string(body)
+	default:
+		text = decodeLatin1(body)
+	}
+
+	var values []string
+	for _, value := range strings.Split(text, "\x00") {
+		if value = cleanTagText(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+// decodeUTF16 без явного порядка байт определяет его по BOM, каждое значение может начинаться со своего BOM
+func decodeUTF16(b []byte, order binary.ByteOrder) string {
+	units := make([]uint16, 0, len(b)/2)
+	current := order
+	for i := 0; i+1 < len(b); i += 2 {
+		switch {
+		case b[i] == 0xFF && b[i+1] == 0xFE && order == nil:
+			current = binary.LittleEndian
+			continue
+		case b[i] == 0xFE && b[i+1] == 0xFF && order == nil:
+			current = binary.BigEndian
+			continue
+		case current == nil:
+			current = binary.LittleEndian
+		}
+		units = append(units, current.Uint16(b[i:]))
+	}
+	return string(utf16.Decode(units))
+}
+
+func decodeLatin1(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+func cleanTagText(s string) string {
+	return strings.TrimSpace(strings.TrimRight(s, "\x00"))
+}
@@ -0,0 +1,163 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mpegFrame собирает кадр MPEG-1 Layer III 44.1 кГц стерео с битрейтом из таблицы по индексу.
+// Аудиоданные нулевые: для разбора важны только заголовок и длина кадра
+func mpegFrame(bitrateIndex byte) []byte {
+	header := []byte{0xFF, 0xFB, bitrateIndex << 4, 0x00}
+	h, _ := parseFrameHeader(header)
+	frame := make([]byte, h.frameLength())
+	copy(frame, header)
+	return frame
+}
+
+// testMP3 — файл с постоянным битрейтом 128 кбит/с из n кадров
+func testMP3(n int) []byte {
+	return bytes.Repeat(mpegFrame(9), n)
+}
+
+// xingFrame — первый кадр VBR-файла с заголовком Xing: число кадров и байт аудиоданных
+func xingFrame(frames, audioBytes uint32) []byte {
+	frame := mpegFrame(9)
+	xing := frame[4+32:]
+	copy(xing, "Xing")
+	binary.BigEndian.PutUint32(xing[4:], 0x3)
+	binary.BigEndian.PutUint32(xing[8:], frames)
+	binary.BigEndian.PutUint32(xing[12:], audioBytes)
+	return frame
+}
+
+// id3v2 собирает тег ID3v2 указанной версии из уже закодированных кадров
+func id3v2(major byte, frames ...[]byte) []byte {
+	body := bytes.Join(frames, nil)
+	size := len(body)
+	tag := []byte{'I', 'D', '3', major, 0, 0,
+		byte(size>>21) & 0x7F, byte(size>>14) & 0x7F, byte(size>>7) & 0x7F, byte(size) & 0x7F}
+	return append(tag, body...)
+}
+
+func id3Frame(major byte, id string, body []byte) []byte {
+	frame := append([]byte(id), 0, 0, 0, 0, 0, 0)
+	if major == 4 {
+		size := len(body)
+		copy(frame[4:], []byte{byte(size>>21) & 0x7F, byte(size>>14) & 0x7F, byte(size>>7) & 0x7F, byte(size) & 0x7F})
+	} else {
+		binary.BigEndian.PutUint32(frame[4:], uint32(len(body)))
+	}
+	return append(frame, body...)
+}
+
+func id3v1(title, artist string) []byte {
+	tag := make([]byte, id3v1Size)
+	copy(tag, "TAG")
+	copy(tag[3:33], title)
+	copy(tag[33:63], artist)
+	return tag
+}
+
+func TestReadMP3Info_CBR(t *testing.T) {
+	// 100 кадров по 1152 сэмпла при 44.1 кГц — 2612 мс. Кадры без padding чуть короче настоящих,
+	// поэтому оценка по размеру файла немного меньше
+	info, err := ReadMP3Info(testMP3(100))
+
+	require.NoError(t, err)
+	assert.Equal(t, 128, info.BitrateKbps)
+	assert.Equal(t, 44100, info.SampleRateHz)
+	assert.InDelta(t, 2612, info.DurationMs, 10)
+	assert.Empty(t, info.Title)
+	assert.Empty(t, info.Artist)
+}
+
+func TestReadMP3Info_XingVBR(t *testing.T) {
+	data := append(xingFrame(1000, 200_000), testMP3(3)...)
+
+	info, err := ReadMP3Info(data)
+
+	require.NoError(t, err)
+	// Длительность из числа кадров в заголовке, а не из размера файла
+	assert.Equal(t, 26122, info.DurationMs)
+	assert.Equal(t, 61, info.BitrateKbps)
+	assert.Equal(t, 44100, info.SampleRateHz)
+}
+
+func TestReadMP3Info_ID3v23UTF16(t *testing.T) {
+	title := []byte{1, 0xFF, 0xFE, 'S', 0, 'o', 0, 'n', 0, 'g', 0}
+	// Два исполнителя, у каждого свой BOM
+	artist := []byte{1, 0xFF, 0xFE, 0x54, 0x05, 0x00, 0x00, 0xFF, 0xFE, 'B', 0}
+	data := append(id3v2(3, id3Frame(3, "TIT2", title), id3Frame(3, "TPE1", artist)), testMP3(10)...)
+
+	info, err := ReadMP3Info(data)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Song", info.Title)
+	assert.Equal(t, "Ք, B", info.Artist)
+	assert.Equal(t, 128, info.BitrateKbps)
+}
+
+func TestReadMP3Info_ID3v24UTF8(t *testing.T) {
+	tag := id3v2(4, id3Frame(4, "TIT2", append([]byte{3}, "Քոչարի"...)), id3Frame(4, "TPE1", append([]byte{3}, "Կարին\x00"...)))
+	data := append(tag, testMP3(10)...)
+
+	info, err := ReadMP3Info(data)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Քոչարի", info.Title)
+	assert.Equal(t, "Կարին", info.Artist)
+}
+
+func TestReadMP3Info_ID3v1Fallback(t *testing.T) {
+	withTag := append(testMP3(100), id3v1("Old title", "Old artist")...)
+	withoutTag := testMP3(100)
+
+	info, err := ReadMP3Info(withTag)
+	require.NoError(t, err)
+	assert.Equal(t, "Old title", info.Title)
+	assert.Equal(t, "Old artist", info.Artist)
+
+	// Тег в конце не считается аудиоданными
+	plain, err := ReadMP3Info(withoutTag)
+	require.NoError(t, err)
+	assert.Equal(t, plain.DurationMs, info.DurationMs)
+}
+
+func TestReadMP3Info_SkipsGarbageBeforeFirstFrame(t *testing.T) {
+	// Одиночный байт синхронизации в мусоре не должен приниматься за кадр
+	data := append([]byte{0x00, 0xFF, 0xFB, 0x90, 0x00, 0x12}, testMP3(5)...)
+
+	info, err := ReadMP3Info(data)
+
+	require.NoError(t, err)
+	assert.Equal(t, 128, info.BitrateKbps)
+}
+
+func TestReadMP3Info_Corrupt(t *testing.T) {
+	tests := map[string][]byte{
+		"not audio":         []byte("fake-content"),
+		"only ID3 tag":      id3v2(3, id3Frame(3, "TIT2", append([]byte{0}, "Title"...))),
+		"truncated tag":     append([]byte("ID3\x03\x00\x00"), 0x7F, 0x7F, 0x7F, 0x7F),
+		"bad bitrate index": bytes.Repeat([]byte{0xFF, 0xFB, 0xF0, 0x00}, 100),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadMP3Info(data)
+			require.ErrorIs(t, err, ErrMediaCorrupt)
+		})
+	}
+}
+
+func TestReadMP3Info_CorruptKeepsTags(t *testing.T) {
+	data := id3v2(3, id3Frame(3, "TIT2", append([]byte{0}, "Title"...)))
+
+	info, err := ReadMP3Info(data)
+
+	require.ErrorIs(t, err, ErrMediaCorrupt)
+	assert.Equal(t, "Title", info.Title)
+}
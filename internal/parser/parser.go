@@ -1,12 +1,9 @@
 package parser
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-
-	"github.com/Ari-Pari/backend/internal/clients/filestorage"
 )
 
 type Parser interface {
@@ -15,6 +12,7 @@ type Parser interface {
 	ParseDancesFile(filename string) ([]DanceDto, error)
 	ParseMusicsFile(filename string) ([]MusicDto, error)
 	ParseVideosFile(filename string) ([]VideoDto, error)
+	ParseGroupsFile(filename string) ([]GroupDto, error)
 }
 
 type jsonParser struct {
@@ -44,6 +42,10 @@ func (j jsonParser) ParseStatesFile(filename string) ([]StateDto, error) {
 	return parseFile(j.fileReader, filename, j.parseStatesReader)
 }
 
+func (j jsonParser) ParseGroupsFile(filename string) ([]GroupDto, error) {
+	return parseFile(j.fileReader, filename, j.parseGroupsReader)
+}
+
 func NewJSONParser() Parser {
 	return jsonParser{
 		fileReader: DefaultFileReader,
@@ -105,22 +107,15 @@ func (j jsonParser) parseStatesReader(r io.Reader) ([]StateDto, error) {
 	return states, nil
 }
 
-func parseFileForStorage(ctx context.Context, storage filestorage.FileStorage, fileReader FileReader, filename string, contentType string) (string, error) {
-	reader, err := fileReader.Open(filename)
-
-	if err != nil {
-		return "", nil
-	}
+func (j jsonParser) parseGroupsReader(r io.Reader) ([]GroupDto, error) {
+	var groups []GroupDto
 
-	fileInfo, err := reader.Stat()
-
-	key, err := storage.UploadFile(ctx, filename, reader, fileInfo.Size(), contentType)
-
-	if err != nil {
-		return "", err
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&groups); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
 	}
 
-	return key, nil
+	return groups, nil
 }
 
 func parseFile[T any](
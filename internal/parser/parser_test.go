@@ -343,3 +343,43 @@ func TestParseFile_FileReaderReturnsNilReader(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "file reader returned nil reader")
 }
+
+func TestParseGroupsFile_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReader := mocks.NewMockFileReader(ctrl)
+
+	// Создаём временный файл с данными
+	tmpfile, err := os.CreateTemp("", "groups.json")
+	require.NoError(t, err)
+	defer os.Remove(tmpfile.Name()) // Удаляем после теста
+
+	// Записываем JSON
+	_, err = tmpfile.Write([]byte(`[{"flag":"🇦🇲","url":"https://example.com/g","type":"YEREVAN","name":{"hy":"Խումբ"}}]`))
+	require.NoError(t, err)
+	_, err = tmpfile.Seek(0, 0)
+	require.NoError(t, err)
+
+	// Мокируем Open — возвращаем *os.File
+	mockReader.EXPECT().
+		Open("groups.json").
+		Return(tmpfile, nil)
+
+	parser := jsonParser{fileReader: mockReader}
+
+	result, err := parser.ParseGroupsFile("groups.json")
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "Խումբ", result[0].Name.ArmName)
+	assert.Equal(t, "🇦🇲", result[0].Flag)
+	assert.Equal(t, GroupTypeYerevan, result[0].Type)
+}
+
+func TestParseGroupsReader_InvalidJSON(t *testing.T) {
+	parser := jsonParser{}
+	_, err := parser.parseGroupsReader(strings.NewReader("invalid json"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid character")
+}
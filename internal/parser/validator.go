@@ -0,0 +1,189 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SourceFiles — разобранные исходные файлы и их имена для отчёта
+type SourceFiles struct {
+	StatesFile  string
+	ArtistsFile string
+	DancesFile  string
+	MusicsFile  string
+	VideosFile  string
+	GroupsFile  string
+
+	States  []StateDto
+	Artists []ArtistDto
+	Dances  []DanceDto
+	Musics  []MusicDto
+	Videos  []VideoDto
+	Groups  []GroupDto
+}
+
+// ValidationProblem — одна проблема в исходных данных
+type ValidationProblem struct {
+	File     string `json:"file"`
+	RecordID string `json:"recordId"` // id записи, для файлов без id — номер в массиве вида "#3"
+	Field    string `json:"field"`
+	Reason   string `json:"reason"`
+}
+
+type ValidationReport struct {
+	Problems []ValidationProblem `json:"problems"`
+}
+
+func (r *ValidationReport) HasProblems() bool {
+	return len(r.Problems) > 0
+}
+
+func (r *ValidationReport) add(file, recordID, field, reason string, args ...any) {
+	r.Problems = append(r.Problems, ValidationProblem{
+		File:     file,
+		RecordID: recordID,
+		Field:    field,
+		Reason:   fmt.Sprintf(reason, args...),
+	})
+}
+
+// WriteText печатает отчёт построчно: файл, запись, поле и причина
+func (r *ValidationReport) WriteText(w io.Writer) error {
+	for _, p := range r.Problems {
+		if _, err := fmt.Fprintf(w, "%s: record %s: %s: %s\n", p.File, p.RecordID, p.Field, p.Reason); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d problem(s) found\n", len(r.Problems))
+	return err
+}
+
+func (r *ValidationReport) WriteJSON(w io.Writer) error {
+	if r.Problems == nil {
+		r.Problems = []ValidationProblem{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// Validate проверяет значения перечислений и ссылки между файлами.
+// Маппинги toDomain* молча превращают неизвестные значения в "", поэтому ловить их нужно до импорта
+func Validate(files SourceFiles) *ValidationReport {
+	report := &ValidationReport{}
+
+	stateIDs := collectIDs(report, files.StatesFile, files.States, func(s StateDto) int64 { return s.Id })
+	artistIDs := collectIDs(report, files.ArtistsFile, files.Artists, func(a ArtistDto) int64 { return a.Id })
+	danceIDs := collectIDs(report, files.DancesFile, files.Dances, func(d DanceDto) int64 { return d.Id })
+	collectIDs(report, files.MusicsFile, files.Musics, func(m MusicDto) int64 { return m.Id })
+
+	for _, artist := range files.Artists {
+		id := strconv.FormatInt(artist.Id, 10)
+		if artist.Type != Active && artist.Type != Extra {
+			report.add(files.ArtistsFile, id, "type", "unknown value %q", artist.Type)
+		}
+	}
+
+	for _, dance := range files.Dances {
+		id := strconv.FormatInt(dance.Id, 10)
+		if dance.Type != Active && dance.Type != Extra {
+			report.add(files.DancesFile, id, "type", "unknown value %q", dance.Type)
+		}
+		if toDomainGender(dance.Gender) == "" {
+			report.add(files.DancesFile, id, "gender", "unknown value %q", dance.Gender)
+		}
+		for i, genre := range dance.Genres {
+			if toDomainGenre(genre) == "" {
+				report.add(files.DancesFile, id, fmt.Sprintf("genres[%d]", i), "unknown value %q", genre)
+			}
+		}
+		for i, holdingType := range dance.HoldingTypes {
+			if toDomainHoldingType(holdingType) == "" {
+				report.add(files.DancesFile, id, fmt.Sprintf("holdingTypes[%d]", i), "unknown value %q", holdingType)
+			}
+		}
+		if dance.Difficult != nil && (*dance.Difficult < 1 || *dance.Difficult > 5) {
+			report.add(files.DancesFile, id, "difficult", "value %d is out of range 1..5", *dance.Difficult)
+		}
+		for i, stateID := range dance.StateIds {
+			if !stateIDs[stateID] {
+				report.add(files.DancesFile, id, fmt.Sprintf("states[%d]", i), "region %d not found in %s", stateID, files.StatesFile)
+			}
+		}
+		urlLists := []struct {
+			field string
+			urls  []string
+		}{
+			{"urlDanceList", dance.DanceUrls},
+			{"urlLessonList", dance.LessonUrls},
+			{"urlSourceList", dance.SourceUrls},
+		}
+		for _, list := range urlLists {
+			for i, url := range list.urls {
+				if strings.TrimSpace(url) == "" {
+					report.add(files.DancesFile, id, fmt.Sprintf("%s[%d]", list.field, i), "empty url")
+				}
+			}
+		}
+	}
+
+	for _, music := range files.Musics {
+		id := strconv.FormatInt(music.Id, 10)
+		if music.Type != Main && music.Type != Extra {
+			report.add(files.MusicsFile, id, "type", "unknown value %q", music.Type)
+		}
+		for i, danceID := range music.DanceIds {
+			if !danceIDs[danceID] {
+				report.add(files.MusicsFile, id, fmt.Sprintf("danceIds[%d]", i), "dance %d not found in %s", danceID, files.DancesFile)
+			}
+		}
+		for i, artistID := range music.Artists {
+			if !artistIDs[artistID] {
+				report.add(files.MusicsFile, id, fmt.Sprintf("groupIds[%d]", i), "artist %d not found in %s", artistID, files.ArtistsFile)
+			}
+		}
+	}
+
+	for n, video := range files.Videos {
+		id := "#" + strconv.Itoa(n)
+		if toDomainVideoType(video.Type) == "" {
+			report.add(files.VideosFile, id, "type", "unknown value %q", video.Type)
+		}
+		if strings.TrimSpace(video.Url) == "" {
+			report.add(files.VideosFile, id, "url", "empty url")
+		}
+		for i, danceID := range video.DanceIds {
+			if !danceIDs[danceID] {
+				report.add(files.VideosFile, id, fmt.Sprintf("danceIds[%d]", i), "dance %d not found in %s", danceID, files.DancesFile)
+			}
+		}
+	}
+
+	for n, group := range files.Groups {
+		id := "#" + strconv.Itoa(n)
+		if toDomainGroupType(group.Type) == "" {
+			report.add(files.GroupsFile, id, "type", "unknown value %q", group.Type)
+		}
+		if countryCodeFromFlag(group.Flag) == "" {
+			report.add(files.GroupsFile, id, "flag", "%q is not a country flag", group.Flag)
+		}
+	}
+
+	return report
+}
+
+// collectIDs собирает множество id файла и отмечает повторы
+func collectIDs[T any](report *ValidationReport, file string, items []T, idOf func(T) int64) map[int64]bool {
+	ids := make(map[int64]bool, len(items))
+	for _, item := range items {
+		id := idOf(item)
+		if ids[id] {
+			report.add(file, strconv.FormatInt(id, 10), "id", "duplicate id")
+		}
+		ids[id] = true
+	}
+	return ids
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validSourceFiles() SourceFiles {
+	difficult := int32(3)
+	return SourceFiles{
+		StatesFile:  "states.json",
+		ArtistsFile: "artists.json",
+		DancesFile:  "dances.json",
+		MusicsFile:  "musics.json",
+		VideosFile:  "videos.json",
+		GroupsFile:  "groups.json",
+		States:      []StateDto{{Id: 1}},
+		Artists:     []ArtistDto{{Id: 10, Type: Active}},
+		Dances: []DanceDto{{
+			Id:           100,
+			Type:         Active,
+			Gender:       Male,
+			Difficult:    &difficult,
+			Genres:       []GenreDto{War},
+			HoldingTypes: []HoldingTypeDto{Palm},
+			StateIds:     []int64{1},
+			DanceUrls:    []string{"https://youtu.be/abc"},
+		}},
+		Musics: []MusicDto{{Id: 1000, Type: Main, DanceIds: []int64{100}, Artists: []int64{10}}},
+		Videos: []VideoDto{{Url: "https://youtu.be/abc", Type: VideoTypeVideo, DanceIds: []int64{100}}},
+		Groups: []GroupDto{{Flag: "🇦🇲", Type: GroupTypeYerevan}},
+	}
+}
+
+func TestValidate_Clean(t *testing.T) {
+	report := Validate(validSourceFiles())
+
+	assert.False(t, report.HasProblems())
+	assert.Empty(t, report.Problems)
+}
+
+func TestValidate_UnknownEnums(t *testing.T) {
+	files := validSourceFiles()
+	files.Dances[0].Gender = "UNKNOWN"
+	files.Dances[0].Genres = []GenreDto{War, ""}
+	files.Musics[0].Type = "SIDE"
+	files.Videos[0].Type = "CLIP"
+
+	report := Validate(files)
+
+	assert.Equal(t, []ValidationProblem{
+		{File: "dances.json", RecordID: "100", Field: "gender", Reason: `unknown value "UNKNOWN"`},
+		{File: "dances.json", RecordID: "100", Field: "genres[1]", Reason: `unknown value ""`},
+		{File: "musics.json", RecordID: "1000", Field: "type", Reason: `unknown value "SIDE"`},
+		{File: "videos.json", RecordID: "#0", Field: "type", Reason: `unknown value "CLIP"`},
+	}, report.Problems)
+}
+
+func TestValidate_DanglingReferences(t *testing.T) {
+	files := validSourceFiles()
+	files.Dances[0].StateIds = []int64{1, 2}
+	files.Musics[0].DanceIds = []int64{39}
+	files.Musics[0].Artists = []int64{10, 11}
+	files.Videos[0].DanceIds = []int64{100, 6}
+
+	report := Validate(files)
+
+	assert.Equal(t, []ValidationProblem{
+		{File: "dances.json", RecordID: "100", Field: "states[1]", Reason: "region 2 not found in states.json"},
+		{File: "musics.json", RecordID: "1000", Field: "danceIds[0]", Reason: "dance 39 not found in dances.json"},
+		{File: "musics.json", RecordID: "1000", Field: "groupIds[1]", Reason: "artist 11 not found in artists.json"},
+		{File: "videos.json", RecordID: "#0", Field: "danceIds[1]", Reason: "dance 6 not found in dances.json"},
+	}, report.Problems)
+}
+
+func TestValidate_DuplicateIDs(t *testing.T) {
+	files := validSourceFiles()
+	files.States = append(files.States, StateDto{Id: 1})
+
+	report := Validate(files)
+
+	require.Len(t, report.Problems, 1)
+	assert.Equal(t, ValidationProblem{File: "states.json", RecordID: "1", Field: "id", Reason: "duplicate id"}, report.Problems[0])
+}
+
+func TestValidationReport_WriteJSON(t *testing.T) {
+	files := validSourceFiles()
+	files.Groups[0].Flag = "AM"
+
+	var buf bytes.Buffer
+	require.NoError(t, Validate(files).WriteJSON(&buf))
+
+	var decoded struct {
+		Problems []map[string]string `json:"problems"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, []map[string]string{{
+		"file":     "groups.json",
+		"recordId": "#0",
+		"field":    "flag",
+		"reason":   `"AM" is not a country flag`,
+	}}, decoded.Problems)
+}
+
+func TestValidationReport_WriteText(t *testing.T) {
+	files := validSourceFiles()
+	difficult := int32(7)
+	files.Dances[0].Difficult = &difficult
+
+	var buf bytes.Buffer
+	require.NoError(t, Validate(files).WriteText(&buf))
+
+	assert.Equal(t, "dances.json: record 100: difficult: value 7 is out of range 1..5\n1 problem(s) found\n", buf.String())
+}
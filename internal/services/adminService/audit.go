@@ -0,0 +1,97 @@
+package adminService
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AuditFilter — условия выборки журнала, пустые поля ничего не ограничивают
+type AuditFilter struct {
+	EntityType  string
+	EntityID    *int64
+	Actor       string
+	Action      audit.Action
+	ImportRunID string
+	From        *time.Time
+	To          *time.Time
+	Page        int
+	Size        int
+}
+
+func (s *adminService) ListAudit(ctx context.Context, filter AuditFilter) ([]audit.Entry, error) {
+	params, err := auditParams(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListAuditLog(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]audit.Entry, len(rows))
+	for i, row := range rows {
+		if entries[i], err = audit.FromDao(row); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// auditParams проверяет фильтр и переводит его в параметры запроса. Страницы считаются с 1
+func auditParams(filter AuditFilter) (db.ListAuditLogParams, error) {
+	problems := &ValidationError{}
+	params := db.ListAuditLogParams{}
+
+	if filter.EntityType != "" {
+		if !slices.Contains(audit.EntityTypes, filter.EntityType) {
+			problems.add("entityType", "unknown value %q", filter.EntityType)
+		}
+		params.EntityType = pgtype.Text{String: filter.EntityType, Valid: true}
+	}
+	if filter.EntityID != nil {
+		params.EntityID = pgtype.Int8{Int64: *filter.EntityID, Valid: true}
+	}
+	if filter.Actor != "" {
+		params.Actor = pgtype.Text{String: filter.Actor, Valid: true}
+	}
+	if filter.Action != "" {
+		if !filter.Action.Valid() {
+			problems.add("action", "unknown value %q", filter.Action)
+		}
+		params.Action = pgtype.Text{String: string(filter.Action), Valid: true}
+	}
+	if filter.ImportRunID != "" {
+		if err := params.ImportRunID.Scan(filter.ImportRunID); err != nil {
+			problems.add("importRunId", "is not a valid UUID")
+		}
+	}
+	if filter.From != nil {
+		params.FromTime = pgtype.Timestamptz{Time: *filter.From, Valid: true}
+	}
+	if filter.To != nil {
+		params.ToTime = pgtype.Timestamptz{Time: *filter.To, Valid: true}
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		problems.add("to", "must be after from")
+	}
+
+	page, size := filter.Page, filter.Size
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 50
+	}
+	if size > 500 {
+		problems.add("size", "value %d is more than 500", size)
+	}
+	params.Limit = int32(size)
+	params.Offset = int32((page - 1) * size)
+
+	return params, problems.err()
+}
@@ -0,0 +1,69 @@
+package adminService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditParams(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		params, err := auditParams(AuditFilter{})
+		require.NoError(t, err)
+
+		assert.False(t, params.EntityType.Valid)
+		assert.False(t, params.ImportRunID.Valid)
+		assert.Equal(t, int32(50), params.Limit)
+		assert.Equal(t, int32(0), params.Offset)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		id := int64(7)
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		params, err := auditParams(AuditFilter{
+			EntityType:  "dance",
+			EntityID:    &id,
+			Action:      audit.Update,
+			ImportRunID: "7d3c6a9e-5b1f-4d2a-9c8e-0f1e2d3c4b5a",
+			From:        &from,
+			Page:        3,
+			Size:        20,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "dance", params.EntityType.String)
+		assert.Equal(t, int64(7), params.EntityID.Int64)
+		assert.Equal(t, "update", params.Action.String)
+		assert.True(t, params.ImportRunID.Valid)
+		assert.Equal(t, from, params.FromTime.Time)
+		assert.False(t, params.ToTime.Valid)
+		assert.Equal(t, int32(20), params.Limit)
+		assert.Equal(t, int32(40), params.Offset)
+	})
+
+	t.Run("All Problems At Once", func(t *testing.T) {
+		from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+		to := from.Add(-time.Hour)
+		_, err := auditParams(AuditFilter{
+			EntityType:  "playlist",
+			Action:      "revert",
+			ImportRunID: "run-1",
+			From:        &from,
+			To:          &to,
+			Size:        1000,
+		})
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []FieldProblem{
+			{Field: "entityType", Reason: `unknown value "playlist"`},
+			{Field: "action", Reason: `unknown value "revert"`},
+			{Field: "importRunId", Reason: "is not a valid UUID"},
+			{Field: "to", Reason: "must be after from"},
+			{Field: "size", Reason: "value 1000 is more than 500"},
+		}, validationErr.Problems)
+	})
+}
@@ -0,0 +1,338 @@
+package adminService
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *adminService) GetDance(ctx context.Context, id int64) (domain.DanceShort, error) {
+	return getDance(ctx, s.queries, id)
+}
+
+func (s *adminService) CreateDance(ctx context.Context, dance domain.DanceShort) (domain.DanceShort, error) {
+	normalizeDance(&dance)
+	var created domain.DanceShort
+	err := s.inTx(ctx, func(q *db.Queries) error {
+		if err := validateDance(ctx, q, dance); err != nil {
+			return err
+		}
+
+		translationIds, err := q.InsertTranslations(ctx, translationParams(dance.Name))
+		if err != nil {
+			return err
+		}
+		params := danceParams(dance, pgtype.Int8{Int64: translationIds[0], Valid: true})
+		id, err := q.CreateDance(ctx, db.CreateDanceParams{
+			TranslationID: params.TranslationID,
+			Name:          params.Name,
+			Complexity:    params.Complexity,
+			Gender:        params.Gender,
+			Paces:         params.Paces,
+			Genres:        params.Genres,
+			Handshakes:    params.Handshakes,
+		})
+		if err != nil {
+			return err
+		}
+		if err = setDanceRegions(ctx, q, id, dance.RegionIds); err != nil {
+			return err
+		}
+
+		created, err = getDance(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		if err = snapshotDances(ctx, q, audit.Actor(ctx), id); err != nil {
+			return err
+		}
+		return record(ctx, q, "dance", id, audit.Create, nil, danceFields(created))
+	})
+	return created, err
+}
+
+func (s *adminService) UpdateDance(ctx context.Context, id int64, update func(dance *domain.DanceShort)) (domain.DanceShort, error) {
+	var updated domain.DanceShort
+	err := s.inTx(ctx, func(q *db.Queries) error {
+		translationID, err := q.LockDance(ctx, id)
+		if err != nil {
+			return notFound(err)
+		}
+		dance, err := getDance(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		before := danceFields(dance)
+		if err = snapshotDances(ctx, q, "", id); err != nil {
+			return err
+		}
+
+		update(&dance)
+		dance.Id = id
+		normalizeDance(&dance)
+		if err = validateDance(ctx, q, dance); err != nil {
+			return err
+		}
+
+		translationID, err = saveTranslation(ctx, q, translationID, dance.Name)
+		if err != nil {
+			return err
+		}
+
+		if err = q.UpdateDance(ctx, danceParams(dance, translationID)); err != nil {
+			return err
+		}
+		if err = setDanceRegions(ctx, q, id, dance.RegionIds); err != nil {
+			return err
+		}
+
+		updated, err = getDance(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		if err = snapshotDances(ctx, q, audit.Actor(ctx), id); err != nil {
+			return err
+		}
+		return record(ctx, q, "dance", id, audit.Update, before, danceFields(updated))
+	})
+	return updated, err
+}
+
+// DeleteDance помечает танец удалённым: связи и файлы остаются, чтобы танец можно было вернуть
+func (s *adminService) DeleteDance(ctx context.Context, id int64) error {
+	return s.inTx(ctx, func(q *db.Queries) error {
+		if err := snapshotDances(ctx, q, "", id); err != nil {
+			return err
+		}
+		if err := softDelete(ctx, q, "dance", id, (*db.Queries).SoftDeleteDance); err != nil {
+			return err
+		}
+		return snapshotDances(ctx, q, audit.Actor(ctx), id)
+	})
+}
+
+func getDance(ctx context.Context, q *db.Queries, id int64) (domain.DanceShort, error) {
+	row, err := q.GetAdminDance(ctx, id)
+	if err != nil {
+		return domain.DanceShort{}, notFound(err)
+	}
+	regionIds, err := q.GetDanceRegionIDs(ctx, id)
+	if err != nil {
+		return domain.DanceShort{}, err
+	}
+
+	dance := domain.DanceShort{
+		Id: row.ID,
+		Name: domain.Translation{
+			EngName: row.EngName.String,
+			RuName:  row.RuName.String,
+			ArmName: row.ArmName.String,
+		},
+		NameKey:      row.Name,
+		Gender:       domain.Gender(row.Gender),
+		Paces:        row.Paces,
+		Genres:       make([]domain.Genre, len(row.Genres)),
+		HoldingTypes: make([]domain.HoldingType, len(row.Handshakes)),
+		RegionIds:    regionIds,
+	}
+	if dance.Paces == nil {
+		dance.Paces = []int32{}
+	}
+	if dance.RegionIds == nil {
+		dance.RegionIds = []int64{}
+	}
+	if row.Complexity.Valid {
+		dance.Complexity = &row.Complexity.Int32
+	}
+	for i, genre := range row.Genres {
+		dance.Genres[i] = domain.Genre(genre)
+	}
+	for i, handshake := range row.Handshakes {
+		dance.HoldingTypes[i] = domain.HoldingType(handshake)
+	}
+	return dance, nil
+}
+
+// danceFields — поля танца для журнала изменений. Сложность берётся по значению:
+// update может поменять её через указатель, и снимок до правки изменился бы вместе с танцем
+func danceFields(dance domain.DanceShort) audit.Fields {
+	var complexity any
+	if dance.Complexity != nil {
+		complexity = *dance.Complexity
+	}
+	return namesFields(audit.Fields{
+		"complexity": complexity,
+		"gender":     dance.Gender,
+		"paces":      dance.Paces,
+		"genres":     dance.Genres,
+		"handshakes": dance.HoldingTypes,
+		"regionIds":  dance.RegionIds,
+	}, dance.Name)
+}
+
+// normalizeDance убирает пробелы по краям названий и дубли в списках. Ключом названия,
+// как и при импорте, служит армянское название
+func normalizeDance(dance *domain.DanceShort) {
+	dance.Name.ArmName = strings.TrimSpace(dance.Name.ArmName)
+	dance.Name.EngName = strings.TrimSpace(dance.Name.EngName)
+	dance.Name.RuName = strings.TrimSpace(dance.Name.RuName)
+	dance.NameKey = dance.Name.ArmName
+
+	dance.Paces = uniqueSorted(dance.Paces)
+	dance.RegionIds = uniqueSorted(dance.RegionIds)
+	dance.Genres = unique(dance.Genres)
+	dance.HoldingTypes = unique(dance.HoldingTypes)
+}
+
+// validateDance проверяет поля по перечислениям domain и те же границы, что и проверка dances.json
+func validateDance(ctx context.Context, q *db.Queries, dance domain.DanceShort) error {
+	problems := &ValidationError{}
+
+	if dance.Name.ArmName == "" {
+		problems.add("names.hy", "is required")
+	}
+	if !dance.Gender.Valid() {
+		problems.add("gender", "unknown value %q", dance.Gender)
+	}
+	if dance.Complexity != nil && (*dance.Complexity < 1 || *dance.Complexity > 5) {
+		problems.add("complexity", "value %d is out of range 1..5", *dance.Complexity)
+	}
+	for _, pace := range dance.Paces {
+		if pace < 1 || pace > 3 {
+			problems.add("paces", "value %d is out of range 1..3", pace)
+		}
+	}
+	for _, genre := range dance.Genres {
+		if !genre.Valid() {
+			problems.add("genres", "unknown value %q", genre)
+		}
+	}
+	for _, handshake := range dance.HoldingTypes {
+		if !handshake.Valid() {
+			problems.add("handshakes", "unknown value %q", handshake)
+		}
+	}
+
+	if err := checkExisting(ctx, problems, "regionIds", "region", dance.RegionIds, q.GetExistingRegionIDs); err != nil {
+		return err
+	}
+
+	return problems.err()
+}
+
+// checkExisting добавляет ошибку поля для каждого id, которого нет среди неудалённых записей
+func checkExisting(ctx context.Context, problems *ValidationError, field, entity string, ids []int64,
+	getExisting func(ctx context.Context, ids []int64) ([]int64, error)) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	existing, err := getExisting(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if !slices.Contains(existing, id) {
+			problems.add(field, "%s %d not found", entity, id)
+		}
+	}
+	return nil
+}
+
+// setDanceRegions приводит связи танца с регионами к списку regionIds
+func setDanceRegions(ctx context.Context, q *db.Queries, danceID int64, regionIds []int64) error {
+	err := q.DeleteDanceRegionsExcept(ctx, db.DeleteDanceRegionsExceptParams{DanceID: danceID, RegionIds: regionIds})
+	if err != nil {
+		return err
+	}
+	if len(regionIds) == 0 {
+		return nil
+	}
+
+	return q.InsertDanceRegions(ctx, db.InsertDanceRegionsParams{DanceIds: repeat(danceID, len(regionIds)), RegionIds: regionIds})
+}
+
+// saveTranslation обновляет перевод записи или создаёт его: записи из старых импортов могли остаться без перевода
+func saveTranslation(ctx context.Context, q *db.Queries, translationID pgtype.Int8, name domain.Translation) (pgtype.Int8, error) {
+	if translationID.Valid {
+		return translationID, q.UpdateTranslations(ctx, db.UpdateTranslationsParams{
+			Ids:      []int64{translationID.Int64},
+			EngNames: []string{name.EngName},
+			RuNames:  []string{name.RuName},
+			ArmNames: []string{name.ArmName},
+		})
+	}
+	translationIds, err := q.InsertTranslations(ctx, translationParams(name))
+	if err != nil {
+		return pgtype.Int8{}, err
+	}
+	return pgtype.Int8{Int64: translationIds[0], Valid: true}, nil
+}
+
+func translationParams(name domain.Translation) db.InsertTranslationsParams {
+	return db.InsertTranslationsParams{
+		EngNames: []string{name.EngName},
+		RuNames:  []string{name.RuName},
+		ArmNames: []string{name.ArmName},
+	}
+}
+
+func danceParams(dance domain.DanceShort, translationID pgtype.Int8) db.UpdateDanceParams {
+	genres := make([]string, len(dance.Genres))
+	for i, genre := range dance.Genres {
+		genres[i] = string(genre)
+	}
+	handshakes := make([]string, len(dance.HoldingTypes))
+	for i, handshake := range dance.HoldingTypes {
+		handshakes[i] = string(handshake)
+	}
+
+	var complexity pgtype.Int4
+	if dance.Complexity != nil {
+		complexity = pgtype.Int4{Int32: *dance.Complexity, Valid: true}
+	}
+
+	return db.UpdateDanceParams{
+		ID:            dance.Id,
+		TranslationID: translationID,
+		Name:          dance.NameKey,
+		Complexity:    complexity,
+		Gender:        string(dance.Gender),
+		Paces:         dance.Paces,
+		Genres:        genres,
+		Handshakes:    handshakes,
+	}
+}
+
+// repeat нужен для вставки связей через unnest: один id в паре с каждым элементом списка
+func repeat(id int64, n int) []int64 {
+	result := make([]int64, n)
+	for i := range result {
+		result[i] = id
+	}
+	return result
+}
+
+func uniqueSorted[T int32 | int64](values []T) []T {
+	result := slices.Clone(values)
+	slices.Sort(result)
+	result = slices.Compact(result)
+	if result == nil {
+		result = []T{}
+	}
+	return result
+}
+
+func unique[T comparable](values []T) []T {
+	result := make([]T, 0, len(values))
+	for _, value := range values {
+		if !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package adminService
+
+import (
+	"testing"
+
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeDance(t *testing.T) {
+	dance := domain.DanceShort{
+		Name:      domain.Translation{ArmName: " Բերդ ", EngName: "Berd ", RuName: "\tБерд"},
+		Paces:     []int32{3, 1, 3},
+		RegionIds: []int64{11, 10, 11},
+		Genres:    []domain.Genre{domain.War, domain.Festive, domain.War},
+	}
+
+	normalizeDance(&dance)
+
+	assert.Equal(t, domain.Translation{ArmName: "Բերդ", EngName: "Berd", RuName: "Берд"}, dance.Name)
+	assert.Equal(t, "Բերդ", dance.NameKey)
+	assert.Equal(t, []int32{1, 3}, dance.Paces)
+	assert.Equal(t, []int64{10, 11}, dance.RegionIds)
+	assert.Equal(t, []domain.Genre{domain.War, domain.Festive}, dance.Genres)
+	assert.Equal(t, []domain.HoldingType{}, dance.HoldingTypes)
+}
+
+func TestValidationError(t *testing.T) {
+	problems := &ValidationError{}
+	assert.NoError(t, problems.err())
+
+	problems.add("paces", "value %d is out of range 1..3", 4)
+	problems.add("names.hy", "is required")
+
+	err := problems.err()
+	assert.EqualError(t, err, "validation failed: paces: value 4 is out of range 1..3; names.hy: is required")
+}
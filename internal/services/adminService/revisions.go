@@ -0,0 +1,234 @@
+package adminService
+
+import (
+	"context"
+	"time"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DanceRevision — сохранённая версия танца вместе со связями
+type DanceRevision struct {
+	Rev       int32
+	CreatedAt time.Time
+	// Actor пустой у версии, снятой до начала истории танца
+	Actor       string
+	ImportRunID string
+	Dance       domain.DanceShort
+	SongIds     []int64
+	VideoIds    []int64
+	Deleted     bool
+}
+
+func (s *adminService) ListDanceRevisions(ctx context.Context, id int64) ([]DanceRevision, error) {
+	exists, err := s.queries.DanceExists(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	rows, err := s.queries.ListDanceRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]DanceRevision, len(rows))
+	for i, row := range rows {
+		revisions[i] = revisionFromDao(row)
+	}
+	return revisions, nil
+}
+
+// RestoreDanceRevision возвращает танцу поля, перевод и связи из версии rev.
+// Связи с регионами, песнями и видео, которых больше нет в базе, пропускаются
+func (s *adminService) RestoreDanceRevision(ctx context.Context, id int64, rev int32) (DanceRevision, error) {
+	var restored DanceRevision
+	err := s.inTx(ctx, func(q *db.Queries) error {
+		translationID, err := q.LockDanceForRestore(ctx, id)
+		if err != nil {
+			return notFound(err)
+		}
+		revision, err := q.GetDanceRevision(ctx, db.GetDanceRevisionParams{DanceID: id, Rev: rev})
+		if err != nil {
+			return notFound(err)
+		}
+
+		if err = snapshotDances(ctx, q, "", id); err != nil {
+			return err
+		}
+		before, err := latestRevision(ctx, q, id)
+		if err != nil {
+			return err
+		}
+
+		translationID, err = saveTranslation(ctx, q, translationID, domain.Translation{
+			EngName: revision.EngName.String,
+			RuName:  revision.RuName.String,
+			ArmName: revision.ArmName.String,
+		})
+		if err != nil {
+			return err
+		}
+		err = q.RestoreDance(ctx, db.RestoreDanceParams{
+			ID:            id,
+			TranslationID: translationID,
+			Name:          revision.Name,
+			Complexity:    revision.Complexity,
+			Gender:        revision.Gender,
+			Paces:         revision.Paces,
+			Genres:        revision.Genres,
+			Handshakes:    revision.Handshakes,
+			Deleted:       revision.Deleted,
+		})
+		if err != nil {
+			return err
+		}
+
+		regionIds, err := q.FilterRegionIDs(ctx, revision.RegionIds)
+		if err != nil {
+			return err
+		}
+		if err = setDanceRegions(ctx, q, id, uniqueSorted(regionIds)); err != nil {
+			return err
+		}
+		songIds, err := q.FilterSongIDs(ctx, revision.SongIds)
+		if err != nil {
+			return err
+		}
+		if err = setDanceSongs(ctx, q, id, uniqueSorted(songIds)); err != nil {
+			return err
+		}
+		videoIds, err := q.FilterVideoIDs(ctx, revision.VideoIds)
+		if err != nil {
+			return err
+		}
+		if err = setDanceVideos(ctx, q, id, uniqueSorted(videoIds)); err != nil {
+			return err
+		}
+
+		if err = snapshotDances(ctx, q, audit.Actor(ctx), id); err != nil {
+			return err
+		}
+		restored, err = latestRevision(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		return record(ctx, q, "dance", id, audit.Restore, revisionFields(before), revisionFields(restored))
+	})
+	return restored, err
+}
+
+// snapshotDances записывает текущее состояние танцев новой версией, если оно отличается от последней.
+// Перед правкой вызывается без автора: так в истории остаётся состояние, бывшее до неё
+func snapshotDances(ctx context.Context, q *db.Queries, actor string, danceIds ...int64) error {
+	danceIds = uniqueSorted(danceIds)
+	if len(danceIds) == 0 {
+		return nil
+	}
+	// Песни и видео правятся без блокировки своих танцев, а номер версии — следующий за последним:
+	// без блокировки параллельные правки одного танца получили бы одинаковый rev
+	if err := q.LockDancesForRevision(ctx, danceIds); err != nil {
+		return err
+	}
+	return q.InsertDanceRevisions(ctx, db.InsertDanceRevisionsParams{
+		DanceIds: danceIds,
+		Actor:    pgtype.Text{String: actor, Valid: actor != ""},
+	})
+}
+
+// withDanceRevisions выполняет правку песни или видео. Их связи входят в версии танцев, поэтому танцы
+// с прежними и новыми связями снимаются до правки без автора и после неё от имени автора
+func withDanceRevisions(ctx context.Context, q *db.Queries, danceIds []int64, edit func() error) error {
+	if err := snapshotDances(ctx, q, "", danceIds...); err != nil {
+		return err
+	}
+	if err := edit(); err != nil {
+		return err
+	}
+	return snapshotDances(ctx, q, audit.Actor(ctx), danceIds...)
+}
+
+func latestRevision(ctx context.Context, q *db.Queries, id int64) (DanceRevision, error) {
+	rows, err := q.ListDanceRevisions(ctx, id)
+	if err != nil {
+		return DanceRevision{}, err
+	}
+	if len(rows) == 0 {
+		return DanceRevision{}, ErrNotFound
+	}
+	return revisionFromDao(rows[0]), nil
+}
+
+// setDanceSongs и setDanceVideos приводят связи танца к спискам так же, как setDanceRegions
+func setDanceSongs(ctx context.Context, q *db.Queries, danceID int64, songIds []int64) error {
+	err := q.DeleteDanceSongsExcept(ctx, db.DeleteDanceSongsExceptParams{DanceID: danceID, SongIds: songIds})
+	if err != nil {
+		return err
+	}
+	if len(songIds) == 0 {
+		return nil
+	}
+	return q.InsertDanceSongs(ctx, db.InsertDanceSongsParams{DanceIds: repeat(danceID, len(songIds)), SongIds: songIds})
+}
+
+func setDanceVideos(ctx context.Context, q *db.Queries, danceID int64, videoIds []int64) error {
+	err := q.DeleteDanceVideosExcept(ctx, db.DeleteDanceVideosExceptParams{DanceID: danceID, VideoIds: videoIds})
+	if err != nil {
+		return err
+	}
+	if len(videoIds) == 0 {
+		return nil
+	}
+	return q.InsertDanceVideos(ctx, db.InsertDanceVideosParams{DanceIds: repeat(danceID, len(videoIds)), VideoIds: videoIds})
+}
+
+// revisionFields — поля версии для журнала изменений: поля танца, связи и пометка об удалении
+func revisionFields(revision DanceRevision) audit.Fields {
+	fields := danceFields(revision.Dance)
+	fields["songIds"] = revision.SongIds
+	fields["videoIds"] = revision.VideoIds
+	fields["deleted"] = revision.Deleted
+	return fields
+}
+
+func revisionFromDao(row db.DanceRevision) DanceRevision {
+	revision := DanceRevision{
+		Rev:       row.Rev,
+		CreatedAt: row.CreatedAt.Time,
+		Actor:     row.Actor.String,
+		Dance: domain.DanceShort{
+			Id: row.DanceID,
+			Name: domain.Translation{
+				EngName: row.EngName.String,
+				RuName:  row.RuName.String,
+				ArmName: row.ArmName.String,
+			},
+			NameKey:      row.Name,
+			Gender:       domain.Gender(row.Gender),
+			Paces:        row.Paces,
+			Genres:       make([]domain.Genre, len(row.Genres)),
+			HoldingTypes: make([]domain.HoldingType, len(row.Handshakes)),
+			RegionIds:    row.RegionIds,
+		},
+		SongIds:  row.SongIds,
+		VideoIds: row.VideoIds,
+		Deleted:  row.Deleted,
+	}
+	if row.ImportRunID.Valid {
+		revision.ImportRunID = row.ImportRunID.String()
+	}
+	if row.Complexity.Valid {
+		revision.Dance.Complexity = &row.Complexity.Int32
+	}
+	for i, genre := range row.Genres {
+		revision.Dance.Genres[i] = domain.Genre(genre)
+	}
+	for i, handshake := range row.Handshakes {
+		revision.Dance.HoldingTypes[i] = domain.HoldingType(handshake)
+	}
+	return revision
+}
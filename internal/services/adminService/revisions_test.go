@@ -0,0 +1,45 @@
+package adminService
+
+import (
+	"testing"
+
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisionFromDao(t *testing.T) {
+	row := db.DanceRevision{
+		DanceID:    7,
+		Rev:        2,
+		Actor:      pgtype.Text{String: "editor@example.com", Valid: true},
+		Name:       "Բերդ",
+		ArmName:    pgtype.Text{String: "Բերդ", Valid: true},
+		Complexity: pgtype.Int4{Int32: 3, Valid: true},
+		Gender:     "MULTY",
+		Paces:      []int32{1, 2},
+		Genres:     []string{"war"},
+		Handshakes: []string{"shoulders"},
+		Deleted:    true,
+		RegionIds:  []int64{10},
+		SongIds:    []int64{20},
+		VideoIds:   []int64{30},
+	}
+
+	revision := revisionFromDao(row)
+
+	assert.Equal(t, int32(2), revision.Rev)
+	assert.Equal(t, "editor@example.com", revision.Actor)
+	assert.Empty(t, revision.ImportRunID)
+	assert.Equal(t, int64(7), revision.Dance.Id)
+	assert.Equal(t, int32(3), *revision.Dance.Complexity)
+	assert.Equal(t, []domain.Genre{"war"}, revision.Dance.Genres)
+	assert.Equal(t, []domain.HoldingType{"shoulders"}, revision.Dance.HoldingTypes)
+
+	fields := revisionFields(revision)
+	assert.Equal(t, []int64{10}, fields["regionIds"])
+	assert.Equal(t, []int64{20}, fields["songIds"])
+	assert.Equal(t, []int64{30}, fields["videoIds"])
+	assert.Equal(t, true, fields["deleted"])
+}
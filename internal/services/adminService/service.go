@@ -0,0 +1,152 @@
+package adminService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrNotFound — записи нет или она удалена
+var ErrNotFound = errors.New("not found")
+
+// TxBeginner — источник транзакций, например *pgxpool.Pool
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// FieldProblem — ошибка в одном поле запроса
+type FieldProblem struct {
+	Field  string
+	Reason string
+}
+
+// ValidationError перечисляет все ошибки запроса сразу, чтобы редактор исправил их за один раз
+type ValidationError struct {
+	Problems []FieldProblem
+}
+
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		problems[i] = p.Field + ": " + p.Reason
+	}
+	return "validation failed: " + strings.Join(problems, "; ")
+}
+
+func (e *ValidationError) add(field, reason string, args ...any) {
+	e.Problems = append(e.Problems, FieldProblem{Field: field, Reason: fmt.Sprintf(reason, args...)})
+}
+
+// err возвращает nil, если ошибок нет: так проверку можно закончить одной строкой
+func (e *ValidationError) err() error {
+	if len(e.Problems) == 0 {
+		return nil
+	}
+	return e
+}
+
+type AdminService interface {
+	GetDance(ctx context.Context, id int64) (domain.DanceShort, error)
+	CreateDance(ctx context.Context, dance domain.DanceShort) (domain.DanceShort, error)
+	// UpdateDance передаёт в update текущие данные танца и сохраняет то, что получилось.
+	// Строка танца заблокирована до конца транзакции, поэтому PATCH не затирает параллельные правки
+	UpdateDance(ctx context.Context, id int64, update func(dance *domain.DanceShort)) (domain.DanceShort, error)
+	DeleteDance(ctx context.Context, id int64) error
+	// ListDanceRevisions возвращает версии танца, новые первыми. Версии удалённого танца тоже доступны
+	ListDanceRevisions(ctx context.Context, id int64) ([]DanceRevision, error)
+	// RestoreDanceRevision одной транзакцией возвращает танец к версии rev и сохраняет результат новой версией
+	RestoreDanceRevision(ctx context.Context, id int64, rev int32) (DanceRevision, error)
+
+	GetSong(ctx context.Context, id int64) (domain.SongShort, error)
+	CreateSong(ctx context.Context, song domain.SongShort) (domain.SongShort, error)
+	// UpdateSong меняет названия и связи песни так же, как UpdateDance. Аудио меняет только ReplaceSongAudio
+	UpdateSong(ctx context.Context, id int64, update func(song *domain.SongShort)) (domain.SongShort, error)
+	DeleteSong(ctx context.Context, id int64) error
+	// ReplaceSongAudio загружает MP3 вместо текущего файла песни и удаляет старый файл, если на него больше никто не ссылается
+	ReplaceSongAudio(ctx context.Context, id int64, fileName string, data []byte) (domain.SongShort, error)
+
+	GetVideo(ctx context.Context, id int64) (domain.VideoShort, error)
+	// CreateVideo и UpdateVideo сводят ссылки YouTube к каноничному виду и не допускают двух видео с одним роликом
+	CreateVideo(ctx context.Context, video domain.VideoShort) (domain.VideoShort, error)
+	UpdateVideo(ctx context.Context, id int64, update func(video *domain.VideoShort)) (domain.VideoShort, error)
+	DeleteVideo(ctx context.Context, id int64) error
+
+	// ListAudit возвращает записи журнала изменений, новые первыми
+	ListAudit(ctx context.Context, filter AuditFilter) ([]audit.Entry, error)
+}
+
+// NewAdminService — pool открывает транзакции, queries выполняет запросы вне и внутри них, storage хранит аудио
+func NewAdminService(pool TxBeginner, queries *db.Queries, storage filestorage.FileStorage) AdminService {
+	return &adminService{
+		pool:    pool,
+		queries: queries,
+		storage: storage,
+	}
+}
+
+type adminService struct {
+	pool    TxBeginner
+	queries *db.Queries
+	storage filestorage.FileStorage
+}
+
+// inTx выполняет изменения одной транзакцией: запись и её связи меняются вместе или не меняются вовсе
+func (s *adminService) inTx(ctx context.Context, fn func(q *db.Queries) error) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err = fn(s.queries.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// notFound переводит отсутствие строки в ErrNotFound
+func notFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// record пишет правку в журнал изменений той же транзакцией q. Автор берётся из контекста запроса
+func record(ctx context.Context, q *db.Queries, entityType string, id int64, action audit.Action, before, after audit.Fields) error {
+	return audit.Record(ctx, q, audit.Actor(ctx), pgtype.UUID{}, audit.Entry{
+		EntityType: entityType,
+		EntityID:   id,
+		Action:     action,
+		Diff:       audit.Diff(before, after),
+	})
+}
+
+// softDelete помечает запись удалённой запросом del, например (*db.Queries).SoftDeleteDance, и пишет это в журнал
+func softDelete(ctx context.Context, q *db.Queries, entityType string, id int64,
+	del func(q *db.Queries, ctx context.Context, id int64) (int64, error)) error {
+	deleted, err := del(q, ctx, id)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return record(ctx, q, entityType, id, audit.Delete, audit.Fields{"deleted": false}, audit.Fields{"deleted": true})
+}
+
+// namesFields раскладывает перевод по полям names.* так же, как его принимает API
+func namesFields(fields audit.Fields, name domain.Translation) audit.Fields {
+	fields["names.hy"] = name.ArmName
+	fields["names.en"] = name.EngName
+	fields["names.ru"] = name.RuName
+	return fields
+}
@@ -0,0 +1,311 @@
+package adminService
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/Ari-Pari/backend/internal/parser"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *adminService) GetSong(ctx context.Context, id int64) (domain.SongShort, error) {
+	return getSong(ctx, s.queries, id)
+}
+
+// CreateSong создаёт песню без аудио: файл загружается отдельно через ReplaceSongAudio
+func (s *adminService) CreateSong(ctx context.Context, song domain.SongShort) (domain.SongShort, error) {
+	normalizeSong(&song)
+	var created domain.SongShort
+	err := s.inTx(ctx, func(q *db.Queries) error {
+		if err := validateSong(ctx, q, song); err != nil {
+			return err
+		}
+
+		var id int64
+		err := withDanceRevisions(ctx, q, song.DanceIds, func() error {
+			translationIds, err := q.InsertTranslations(ctx, translationParams(song.Name))
+			if err != nil {
+				return err
+			}
+			id, err = q.CreateSong(ctx, db.CreateSongParams{
+				TranslationID: pgtype.Int8{Int64: translationIds[0], Valid: true},
+				Name:          song.NameKey,
+			})
+			if err != nil {
+				return err
+			}
+			if err = setSongLinks(ctx, q, id, song); err != nil {
+				return err
+			}
+			created, err = getSong(ctx, q, id)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return record(ctx, q, "song", id, audit.Create, nil, songFields(created))
+	})
+	return created, err
+}
+
+func (s *adminService) UpdateSong(ctx context.Context, id int64, update func(song *domain.SongShort)) (domain.SongShort, error) {
+	var updated domain.SongShort
+	err := s.inTx(ctx, func(q *db.Queries) error {
+		locked, err := q.LockSong(ctx, id)
+		if err != nil {
+			return notFound(err)
+		}
+		song, err := getSong(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		before := songFields(song)
+		beforeDanceIds := song.DanceIds
+
+		update(&song)
+		song.Id = id
+		normalizeSong(&song)
+		if err = validateSong(ctx, q, song); err != nil {
+			return err
+		}
+
+		err = withDanceRevisions(ctx, q, slices.Concat(beforeDanceIds, song.DanceIds), func() error {
+			translationID, err := saveTranslation(ctx, q, locked.TranslationID, song.Name)
+			if err != nil {
+				return err
+			}
+			err = q.UpdateSong(ctx, db.UpdateSongParams{ID: id, TranslationID: translationID, Name: song.NameKey})
+			if err != nil {
+				return err
+			}
+			if err = setSongLinks(ctx, q, id, song); err != nil {
+				return err
+			}
+			updated, err = getSong(ctx, q, id)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return record(ctx, q, "song", id, audit.Update, before, songFields(updated))
+	})
+	return updated, err
+}
+
+// DeleteSong помечает песню удалённой. Файл остаётся: песню можно вернуть, а сирот убирает сборщик медиа
+func (s *adminService) DeleteSong(ctx context.Context, id int64) error {
+	return s.inTx(ctx, func(q *db.Queries) error {
+		return softDelete(ctx, q, "song", id, (*db.Queries).SoftDeleteSong)
+	})
+}
+
+func (s *adminService) ReplaceSongAudio(ctx context.Context, id int64, fileName string, data []byte) (domain.SongShort, error) {
+	// Битый файл из админки не сохраняем, в отличие от импорта: редактор может сразу загрузить другой
+	info, err := parser.ReadMP3Info(data)
+	if err != nil {
+		problems := &ValidationError{}
+		problems.add("file", "is not a valid MP3: %v", err)
+		return domain.SongShort{}, problems
+	}
+	// Проверка до загрузки, чтобы не оставлять в хранилище файлы несуществующих песен
+	if _, err = s.queries.GetAdminSong(ctx, id); err != nil {
+		return domain.SongShort{}, notFound(err)
+	}
+
+	// Ключ зависит только от содержимого: такой файл может уже быть у этой или другой песни,
+	// в том числе удалённой. При откате удалять можно только файл, созданный этой загрузкой
+	created, err := s.fileMissing(ctx, filestorage.ContentKey(data, fileName))
+	if err != nil {
+		return domain.SongShort{}, err
+	}
+	newKey, err := s.storage.UploadFile(ctx, fileName, bytes.NewReader(data), int64(len(data)), parser.AudioContentType)
+	if err != nil {
+		return domain.SongShort{}, fmt.Errorf("upload audio: %w", err)
+	}
+
+	var updated domain.SongShort
+	var oldKeyUnused bool
+	var oldKey string
+	err = s.inTx(ctx, func(q *db.Queries) error {
+		locked, err := q.LockSong(ctx, id)
+		if err != nil {
+			return notFound(err)
+		}
+		oldKey = locked.FileKey
+		before, err := getSong(ctx, q, id)
+		if err != nil {
+			return err
+		}
+
+		err = q.SetSongAudio(ctx, db.SetSongAudioParams{
+			ID:           id,
+			FileKey:      newKey,
+			DurationMs:   optionalInt4(info.DurationMs),
+			BitrateKbps:  optionalInt4(info.BitrateKbps),
+			SampleRateHz: optionalInt4(info.SampleRateHz),
+			TagTitle:     info.Title,
+			TagArtist:    info.Artist,
+		})
+		if err != nil {
+			return err
+		}
+
+		if oldKey != "" && oldKey != newKey {
+			refs, err := q.CountSongsByFileKey(ctx, oldKey)
+			if err != nil {
+				return err
+			}
+			oldKeyUnused = refs == 0
+		}
+
+		updated, err = getSong(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		return record(ctx, q, "song", id, audit.Update, songFields(before), songFields(updated))
+	})
+	if err != nil {
+		// Песня не изменилась. Файл, на который успел сослаться кто-то ещё, остаётся сборщику медиа
+		if created {
+			if refs, countErr := s.queries.CountSongsByFileKey(ctx, newKey); countErr == nil && refs == 0 {
+				_ = s.storage.DeleteFile(ctx, newKey)
+			}
+		}
+		return domain.SongShort{}, err
+	}
+
+	// Удаляем после коммита: при ошибке файл станет сиротой, и его уберёт сборщик медиа
+	if oldKeyUnused {
+		_ = s.storage.DeleteFile(ctx, oldKey)
+	}
+	return updated, nil
+}
+
+// fileMissing сообщает, что в хранилище ещё нет файла с ключом key
+func (s *adminService) fileMissing(ctx context.Context, key string) (bool, error) {
+	file, err := s.storage.OpenFile(ctx, key)
+	if errors.Is(err, filestorage.ErrFileNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return false, file.Close()
+}
+
+func getSong(ctx context.Context, q *db.Queries, id int64) (domain.SongShort, error) {
+	row, err := q.GetAdminSong(ctx, id)
+	if err != nil {
+		return domain.SongShort{}, notFound(err)
+	}
+	danceIds, err := q.GetSongDanceIDs(ctx, id)
+	if err != nil {
+		return domain.SongShort{}, err
+	}
+	artistIds, err := q.GetSongArtistIDs(ctx, id)
+	if err != nil {
+		return domain.SongShort{}, err
+	}
+
+	song := domain.SongShort{
+		Id: row.ID,
+		Name: domain.Translation{
+			EngName: row.EngName.String,
+			RuName:  row.RuName.String,
+			ArmName: row.ArmName.String,
+		},
+		NameKey:     row.Name,
+		DanceIds:    danceIds,
+		ArtistIds:   artistIds,
+		MediaStatus: domain.MediaStatus(row.MediaStatus),
+		Audio: domain.AudioInfo{
+			DurationMs:   int(row.DurationMs.Int32),
+			BitrateKbps:  int(row.BitrateKbps.Int32),
+			SampleRateHz: int(row.SampleRateHz.Int32),
+			Title:        row.TagTitle,
+			Artist:       row.TagArtist,
+		},
+	}
+	if row.FileKey != "" {
+		song.FileKey = &row.FileKey
+	}
+	return song, nil
+}
+
+// normalizeSong работает как normalizeDance: ключ названия — армянское название
+func normalizeSong(song *domain.SongShort) {
+	song.Name.ArmName = strings.TrimSpace(song.Name.ArmName)
+	song.Name.EngName = strings.TrimSpace(song.Name.EngName)
+	song.Name.RuName = strings.TrimSpace(song.Name.RuName)
+	song.NameKey = song.Name.ArmName
+
+	song.DanceIds = uniqueSorted(song.DanceIds)
+	song.ArtistIds = uniqueSorted(song.ArtistIds)
+}
+
+func validateSong(ctx context.Context, q *db.Queries, song domain.SongShort) error {
+	problems := &ValidationError{}
+
+	if song.Name.ArmName == "" {
+		problems.add("names.hy", "is required")
+	}
+	if err := checkExisting(ctx, problems, "danceIds", "dance", song.DanceIds, q.GetExistingDanceIDs); err != nil {
+		return err
+	}
+	if err := checkExisting(ctx, problems, "ensembleIds", "ensemble", song.ArtistIds, q.GetExistingArtistIDs); err != nil {
+		return err
+	}
+
+	return problems.err()
+}
+
+// setSongLinks приводит связи песни с танцами и ансамблями к спискам из song
+func setSongLinks(ctx context.Context, q *db.Queries, songID int64, song domain.SongShort) error {
+	err := q.DeleteSongDancesExcept(ctx, db.DeleteSongDancesExceptParams{SongID: songID, DanceIds: song.DanceIds})
+	if err != nil {
+		return err
+	}
+	if len(song.DanceIds) > 0 {
+		err = q.InsertDanceSongs(ctx, db.InsertDanceSongsParams{DanceIds: song.DanceIds, SongIds: repeat(songID, len(song.DanceIds))})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = q.DeleteSongArtistsExcept(ctx, db.DeleteSongArtistsExceptParams{SongID: songID, ArtistIds: song.ArtistIds})
+	if err != nil {
+		return err
+	}
+	if len(song.ArtistIds) == 0 {
+		return nil
+	}
+	return q.InsertSongArtists(ctx, db.InsertSongArtistsParams{SongIds: repeat(songID, len(song.ArtistIds)), ArtistIds: song.ArtistIds})
+}
+
+// optionalInt4 записывает ноль как NULL: так же импорт хранит непрочитанные данные аудио
+func optionalInt4(v int) pgtype.Int4 {
+	return pgtype.Int4{Int32: int32(v), Valid: v != 0}
+}
+
+// songFields — поля песни для журнала изменений: названия, связи и файл аудио
+func songFields(song domain.SongShort) audit.Fields {
+	var fileKey any
+	if song.FileKey != nil {
+		fileKey = *song.FileKey
+	}
+	return namesFields(audit.Fields{
+		"danceIds":    song.DanceIds,
+		"ensembleIds": song.ArtistIds,
+		"fileKey":     fileKey,
+		"mediaStatus": song.MediaStatus,
+		"durationMs":  song.Audio.DurationMs,
+	}, song.Name)
+}
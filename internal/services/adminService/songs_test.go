@@ -0,0 +1,36 @@
+package adminService
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSong(t *testing.T) {
+	song := domain.SongShort{
+		Name:     domain.Translation{ArmName: " Երգ", EngName: "Song ", RuName: "Песня"},
+		DanceIds: []int64{5, 1, 5},
+	}
+
+	normalizeSong(&song)
+
+	assert.Equal(t, "Երգ", song.NameKey)
+	assert.Equal(t, "Song", song.Name.EngName)
+	assert.Equal(t, []int64{1, 5}, song.DanceIds)
+	assert.Equal(t, []int64{}, song.ArtistIds)
+}
+
+func TestReplaceSongAudio_RejectsNotMP3(t *testing.T) {
+	// Проверка файла идёт до обращений к базе и хранилищу
+	s := &adminService{}
+
+	_, err := s.ReplaceSongAudio(context.Background(), 1, "song.mp3", []byte("not an mp3"))
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr.Problems, 1)
+	assert.Equal(t, "file", validationErr.Problems[0].Field)
+}
@@ -0,0 +1,222 @@
+package adminService
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *adminService) GetVideo(ctx context.Context, id int64) (domain.VideoShort, error) {
+	return getVideo(ctx, s.queries, id)
+}
+
+func (s *adminService) CreateVideo(ctx context.Context, video domain.VideoShort) (domain.VideoShort, error) {
+	normalizeVideo(&video)
+	var created domain.VideoShort
+	err := s.inTx(ctx, func(q *db.Queries) error {
+		if err := q.LockVideoLinks(ctx); err != nil {
+			return err
+		}
+		if err := validateVideo(ctx, q, video); err != nil {
+			return err
+		}
+
+		var id int64
+		err := withDanceRevisions(ctx, q, video.DanceIds, func() error {
+			translationIds, err := q.InsertTranslations(ctx, translationParams(video.Name))
+			if err != nil {
+				return err
+			}
+			id, err = q.CreateVideo(ctx, db.CreateVideoParams{
+				Link:          video.Link,
+				TranslationID: pgtype.Int8{Int64: translationIds[0], Valid: true},
+				Name:          video.NameKey,
+				Type:          string(video.Type),
+			})
+			if err != nil {
+				return err
+			}
+			if err = setVideoDances(ctx, q, id, video.DanceIds); err != nil {
+				return err
+			}
+			created, err = getVideo(ctx, q, id)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return record(ctx, q, "video", id, audit.Create, nil, videoFields(created))
+	})
+	return created, err
+}
+
+func (s *adminService) UpdateVideo(ctx context.Context, id int64, update func(video *domain.VideoShort)) (domain.VideoShort, error) {
+	var updated domain.VideoShort
+	err := s.inTx(ctx, func(q *db.Queries) error {
+		if err := q.LockVideoLinks(ctx); err != nil {
+			return err
+		}
+		translationID, err := q.LockVideo(ctx, id)
+		if err != nil {
+			return notFound(err)
+		}
+		video, err := getVideo(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		before := videoFields(video)
+		beforeDanceIds := video.DanceIds
+
+		update(&video)
+		video.Id = &id
+		normalizeVideo(&video)
+		if err = validateVideo(ctx, q, video); err != nil {
+			return err
+		}
+
+		err = withDanceRevisions(ctx, q, slices.Concat(beforeDanceIds, video.DanceIds), func() error {
+			var err error
+			translationID, err = saveTranslation(ctx, q, translationID, video.Name)
+			if err != nil {
+				return err
+			}
+			err = q.UpdateVideo(ctx, db.UpdateVideoParams{
+				ID:            id,
+				Link:          video.Link,
+				TranslationID: translationID,
+				Name:          video.NameKey,
+				Type:          string(video.Type),
+			})
+			if err != nil {
+				return err
+			}
+			if err = setVideoDances(ctx, q, id, video.DanceIds); err != nil {
+				return err
+			}
+			updated, err = getVideo(ctx, q, id)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return record(ctx, q, "video", id, audit.Update, before, videoFields(updated))
+	})
+	return updated, err
+}
+
+// DeleteVideo помечает видео удалённым, связи с танцами остаются для восстановления
+func (s *adminService) DeleteVideo(ctx context.Context, id int64) error {
+	return s.inTx(ctx, func(q *db.Queries) error {
+		return softDelete(ctx, q, "video", id, (*db.Queries).SoftDeleteVideo)
+	})
+}
+
+func getVideo(ctx context.Context, q *db.Queries, id int64) (domain.VideoShort, error) {
+	row, err := q.GetAdminVideo(ctx, id)
+	if err != nil {
+		return domain.VideoShort{}, notFound(err)
+	}
+	danceIds, err := q.GetVideoDanceIDs(ctx, id)
+	if err != nil {
+		return domain.VideoShort{}, err
+	}
+
+	return domain.VideoShort{
+		Id: &row.ID,
+		Name: domain.Translation{
+			EngName: row.EngName.String,
+			RuName:  row.RuName.String,
+			ArmName: row.ArmName.String,
+		},
+		Type:     domain.VideoType(strings.ToUpper(row.Type)),
+		NameKey:  row.Name,
+		Link:     row.Link,
+		DanceIds: danceIds,
+	}, nil
+}
+
+// videoFields — поля видео для журнала изменений
+func videoFields(video domain.VideoShort) audit.Fields {
+	return namesFields(audit.Fields{
+		"link":     video.Link,
+		"type":     video.Type,
+		"danceIds": video.DanceIds,
+	}, video.Name)
+}
+
+// normalizeVideo сводит ссылки YouTube к виду watch?v=<id>, чтобы в базе не копились разные формы одного ролика
+func normalizeVideo(video *domain.VideoShort) {
+	video.Name.ArmName = strings.TrimSpace(video.Name.ArmName)
+	video.Name.EngName = strings.TrimSpace(video.Name.EngName)
+	video.Name.RuName = strings.TrimSpace(video.Name.RuName)
+	video.NameKey = video.Name.ArmName
+
+	video.Link = strings.TrimSpace(video.Link)
+	if youtube, ok := domain.ParseYouTubeURL(video.Link); ok {
+		video.Link = youtube.WatchURL()
+	}
+	video.DanceIds = uniqueSorted(video.DanceIds)
+}
+
+func validateVideo(ctx context.Context, q *db.Queries, video domain.VideoShort) error {
+	problems := &ValidationError{}
+
+	if video.Name.ArmName == "" {
+		problems.add("names.hy", "is required")
+	}
+	if !video.Type.Valid() {
+		problems.add("type", "unknown value %q", video.Type)
+	}
+	if err := validateVideoLink(ctx, q, problems, video); err != nil {
+		return err
+	}
+	if err := checkExisting(ctx, problems, "danceIds", "dance", video.DanceIds, q.GetExistingDanceIDs); err != nil {
+		return err
+	}
+
+	return problems.err()
+}
+
+// validateVideoLink проверяет, что ссылка ведёт на сайт, и что другого видео с тем же роликом нет
+func validateVideoLink(ctx context.Context, q *db.Queries, problems *ValidationError, video domain.VideoShort) error {
+	u, err := url.Parse(video.Link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		problems.add("link", "must be an http or https URL")
+		return nil
+	}
+	if _, ok := domain.ParseYouTubeURL(video.Link); !ok && domain.IsYouTubeURL(video.Link) {
+		problems.add("link", "no YouTube video id in the URL")
+		return nil
+	}
+
+	existing, err := q.GetActiveVideoLinks(ctx)
+	if err != nil {
+		return err
+	}
+	key := domain.NormalizeVideoURL(video.Link)
+	for _, other := range existing {
+		if (video.Id == nil || other.ID != *video.Id) && domain.NormalizeVideoURL(other.Link) == key {
+			problems.add("link", "video %d already has this link", other.ID)
+			break
+		}
+	}
+	return nil
+}
+
+// setVideoDances приводит связи видео с танцами к списку danceIds
+func setVideoDances(ctx context.Context, q *db.Queries, videoID int64, danceIds []int64) error {
+	err := q.DeleteVideoDancesExcept(ctx, db.DeleteVideoDancesExceptParams{VideoID: videoID, DanceIds: danceIds})
+	if err != nil {
+		return err
+	}
+	if len(danceIds) == 0 {
+		return nil
+	}
+	return q.InsertDanceVideos(ctx, db.InsertDanceVideosParams{DanceIds: danceIds, VideoIds: repeat(videoID, len(danceIds))})
+}
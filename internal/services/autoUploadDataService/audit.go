@@ -0,0 +1,117 @@
+package autoUploadDataService
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// auditEntries переводит отчёт о различиях в записи журнала изменений.
+// У видео и групп в отчёте ключ — не id, он берётся из ids
+func auditEntries(report *DiffReport, ids keyIDs) ([]audit.Entry, error) {
+	entries := make([]audit.Entry, 0, len(report.Changes))
+	for _, c := range report.Changes {
+		if c.Kind == Skipped {
+			continue
+		}
+		entry := audit.Entry{EntityType: c.Entity, Diff: make(map[string]audit.Change, len(c.Fields))}
+
+		if byKey, ok := ids[c.Entity]; ok {
+			id, ok := byKey[c.Key]
+			if !ok {
+				return nil, fmt.Errorf("%s %s: id not found", c.Entity, c.Key)
+			}
+			entry.EntityID = id
+		} else {
+			id, err := strconv.ParseInt(c.Key, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", c.Entity, c.Key, err)
+			}
+			entry.EntityID = id
+		}
+
+		switch c.Kind {
+		case Added:
+			entry.Action = audit.Create
+			for _, f := range c.Fields {
+				entry.Diff[f.Field] = audit.Change{New: f.New}
+			}
+		case Changed:
+			entry.Action = audit.Update
+			for _, f := range c.Fields {
+				entry.Diff[f.Field] = audit.Change{Old: f.Old, New: f.New}
+			}
+		case Removed:
+			entry.Action = audit.Delete
+			entry.Diff["deleted"] = audit.Change{Old: formatBool(false), New: formatBool(true)}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// keyIDs — id записей по ключам отчёта для сущностей, у которых в источнике нет id
+type keyIDs map[string]map[string]int64
+
+// entityIDs сопоставляет ключи с id так же, как diffVideos и diffGroups: видео — по нормализованной
+// ссылке, группы — по армянскому имени, при дублях берётся первая строка
+func entityIDs(ctx context.Context, querier db.Querier) (keyIDs, error) {
+	videos, err := querier.GetVideos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := querier.GetGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := keyIDs{"video": make(map[string]int64, len(videos)), "group": make(map[string]int64, len(groups))}
+	for _, v := range videos {
+		ids.add("video", domain.NormalizeVideoURL(v.Link), v.ID)
+	}
+	for _, g := range groups {
+		ids.add("group", g.Name, g.ID)
+	}
+	return ids, nil
+}
+
+// add запоминает id, если у ключа его ещё нет
+func (ids keyIDs) add(entity, key string, id int64) {
+	if _, ok := ids[entity][key]; !ok {
+		ids[entity][key] = id
+	}
+}
+
+// merge переносит id из other поверх имеющихся: после загрузки с truncate у тех же ключей новые id
+func (ids keyIDs) merge(other keyIDs) {
+	for entity, byKey := range other {
+		if ids[entity] == nil {
+			ids[entity] = make(map[string]int64, len(byKey))
+		}
+		for key, id := range byKey {
+			ids[entity][key] = id
+		}
+	}
+}
+
+// snapshotDances записывает версии всех танцев. Запрос пропускает танцы, не изменившиеся с последней версии,
+// поэтому новые строки появятся только у тех, которых коснулась загрузка
+func snapshotDances(ctx context.Context, querier db.Querier, actor pgtype.Text, importRunID pgtype.UUID) error {
+	dances, err := querier.GetDances(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, len(dances))
+	for i, d := range dances {
+		ids[i] = d.ID
+	}
+	if err = querier.LockDancesForRevision(ctx, ids); err != nil {
+		return err
+	}
+	return querier.InsertDanceRevisions(ctx, db.InsertDanceRevisionsParams{DanceIds: ids, Actor: actor, ImportRunID: importRunID})
+}
@@ -0,0 +1,512 @@
+package autoUploadDataService
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ImportData — разобранные исходные файлы, готовые к загрузке
+type ImportData struct {
+	Regions []domain.Region
+	Artists []domain.ArtistShort
+	Dances  []domain.DanceShort
+	Songs   []domain.SongShort
+	Videos  []domain.VideoShort
+	Groups  []domain.Group
+}
+
+type ChangeKind string
+
+const (
+	Added   ChangeKind = "added"
+	Changed ChangeKind = "changed"
+	Removed ChangeKind = "removed"
+	// Skipped — запись отличается от источника, но её правили в админке, и загрузка её не меняет
+	Skipped ChangeKind = "skipped"
+)
+
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// EntityChange — изменение одной записи: Key — id из источника, для видео — нормализованная ссылка,
+// для групп — армянское имя
+type EntityChange struct {
+	Entity string        `json:"entity"`
+	Key    string        `json:"key"`
+	Kind   ChangeKind    `json:"kind"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+type DiffReport struct {
+	Changes []EntityChange `json:"changes"`
+}
+
+// Diff сравнивает данные из источника с текущим содержимым базы, ничего не записывая.
+// Ключи файлов сравниваются как обычные поля: они строятся по содержимому, поэтому меняются только вместе с файлом
+func (a autoUploadDataService) Diff(ctx context.Context, data ImportData) (*DiffReport, error) {
+	translations, err := a.querier.GetTranslations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int64]domain.Translation, len(translations))
+	for _, t := range translations {
+		names[t.ID] = domain.Translation{EngName: t.EngName.String, RuName: t.RuName.String, ArmName: t.ArmName.String}
+	}
+	nameOf := func(id pgtype.Int8) domain.Translation {
+		if !id.Valid {
+			return domain.Translation{}
+		}
+		return names[id.Int64]
+	}
+
+	report := &DiffReport{}
+
+	if err = a.diffRegions(ctx, report, data.Regions, nameOf); err != nil {
+		return nil, err
+	}
+	if err = a.diffArtists(ctx, report, data.Artists, nameOf); err != nil {
+		return nil, err
+	}
+	if err = a.diffDances(ctx, report, data.Dances, nameOf); err != nil {
+		return nil, err
+	}
+	if err = a.diffSongs(ctx, report, data.Songs, nameOf); err != nil {
+		return nil, err
+	}
+	if err = a.diffVideos(ctx, report, data.Videos, nameOf); err != nil {
+		return nil, err
+	}
+	if err = a.diffGroups(ctx, report, data.Groups, nameOf); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (a autoUploadDataService) diffRegions(ctx context.Context, report *DiffReport, regions []domain.Region, nameOf func(pgtype.Int8) domain.Translation) error {
+	existing, err := a.querier.GetRegions(ctx)
+	if err != nil {
+		return err
+	}
+	current := make(map[int64]db.GetRegionsRow, len(existing))
+	for _, r := range existing {
+		current[r.ID] = r
+	}
+
+	seen := make(map[int64]bool, len(regions))
+	for _, region := range regions {
+		seen[region.Id] = true
+		old, ok := current[region.Id]
+		fields := diffFields(
+			translationFields(nameOf(old.TranslationID), region.Name),
+			field("name", old.Name, region.Name.ArmName),
+			field("deleted", formatBool(old.DeletedAt.Valid), formatBool(false)),
+		)
+		report.add("region", strconv.FormatInt(region.Id, 10), ok, fields)
+	}
+	for _, r := range existing {
+		if !seen[r.ID] && !r.DeletedAt.Valid {
+			report.remove("region", strconv.FormatInt(r.ID, 10))
+		}
+	}
+	return nil
+}
+
+func (a autoUploadDataService) diffArtists(ctx context.Context, report *DiffReport, artists []domain.ArtistShort, nameOf func(pgtype.Int8) domain.Translation) error {
+	existing, err := a.querier.GetArtists(ctx)
+	if err != nil {
+		return err
+	}
+	current := make(map[int64]db.GetArtistsRow, len(existing))
+	for _, r := range existing {
+		current[r.ID] = r
+	}
+
+	seen := make(map[int64]bool, len(artists))
+	for _, artist := range artists {
+		seen[artist.Id] = true
+		old, ok := current[artist.Id]
+		fields := diffFields(
+			translationFields(nameOf(old.TranslationID), artist.Name),
+			field("name", old.Name, artist.NameKey),
+			field("link", old.Link, artist.Url),
+			field("deleted", formatBool(old.DeletedAt.Valid), formatBool(artist.DeletedAt != nil)),
+		)
+		report.add("artist", strconv.FormatInt(artist.Id, 10), ok, fields)
+	}
+	for _, r := range existing {
+		if !seen[r.ID] && !r.DeletedAt.Valid {
+			report.remove("artist", strconv.FormatInt(r.ID, 10))
+		}
+	}
+	return nil
+}
+
+func (a autoUploadDataService) diffDances(ctx context.Context, report *DiffReport, dances []domain.DanceShort, nameOf func(pgtype.Int8) domain.Translation) error {
+	existing, err := a.querier.GetDances(ctx)
+	if err != nil {
+		return err
+	}
+	current := make(map[int64]db.GetDancesRow, len(existing))
+	for _, r := range existing {
+		current[r.ID] = r
+	}
+
+	links, err := a.querier.GetDanceRegions(ctx)
+	if err != nil {
+		return err
+	}
+	regionsByDance := make(map[int64][]int64)
+	for _, l := range links {
+		regionsByDance[l.DanceID] = append(regionsByDance[l.DanceID], l.RegionID)
+	}
+
+	seen := make(map[int64]bool, len(dances))
+	for _, dance := range dances {
+		seen[dance.Id] = true
+		old, ok := current[dance.Id]
+
+		newComplexity := ""
+		if dance.Complexity != nil {
+			newComplexity = strconv.Itoa(int(*dance.Complexity))
+		}
+		oldComplexity := ""
+		if old.Complexity.Valid {
+			oldComplexity = strconv.Itoa(int(old.Complexity.Int32))
+		}
+		genres := make([]string, len(dance.Genres))
+		for i, g := range dance.Genres {
+			genres[i] = string(g)
+		}
+		handshakes := make([]string, len(dance.HoldingTypes))
+		for i, h := range dance.HoldingTypes {
+			handshakes[i] = string(h)
+		}
+
+		fields := diffFields(
+			translationFields(nameOf(old.TranslationID), dance.Name),
+			field("name", old.Name, dance.NameKey),
+			field("complexity", oldComplexity, newComplexity),
+			field("gender", old.Gender, string(dance.Gender)),
+			field("paces", formatInts(old.Paces), formatInts(dance.Paces)),
+			field("genres", strings.Join(old.Genres, ","), strings.Join(genres, ",")),
+			field("handshakes", strings.Join(old.Handshakes, ","), strings.Join(handshakes, ",")),
+			field("regions", formatIDs(regionsByDance[dance.Id]), formatIDs(dance.RegionIds)),
+			field("photo_key", old.PhotoKey.String, formatKey(dance.FileKey)),
+			field("photo_variants", formatPhotoVariants(old.PhotoVariants), string(PhotoVariantsToDao(dance.PhotoVariants))),
+			field("media_status", old.MediaStatus, MediaStatusToDao(dance.MediaStatus)),
+			field("deleted", formatBool(old.DeletedAt.Valid), formatBool(dance.DeletedAt != nil)),
+		)
+		if old.ModifiedInAdmin {
+			report.skip("dance", strconv.FormatInt(dance.Id, 10), fields)
+			continue
+		}
+		report.add("dance", strconv.FormatInt(dance.Id, 10), ok, fields)
+	}
+	for _, r := range existing {
+		if !seen[r.ID] && !r.DeletedAt.Valid && !r.CreatedInAdmin && !r.ModifiedInAdmin {
+			report.remove("dance", strconv.FormatInt(r.ID, 10))
+		}
+	}
+	return nil
+}
+
+func (a autoUploadDataService) diffSongs(ctx context.Context, report *DiffReport, songs []domain.SongShort, nameOf func(pgtype.Int8) domain.Translation) error {
+	existing, err := a.querier.GetSongs(ctx)
+	if err != nil {
+		return err
+	}
+	current := make(map[int64]db.GetSongsRow, len(existing))
+	for _, r := range existing {
+		current[r.ID] = r
+	}
+
+	danceLinks, err := a.querier.GetDanceSongs(ctx)
+	if err != nil {
+		return err
+	}
+	dancesBySong := make(map[int64][]int64)
+	for _, l := range danceLinks {
+		dancesBySong[l.SongID] = append(dancesBySong[l.SongID], l.DanceID)
+	}
+	artistLinks, err := a.querier.GetSongArtists(ctx)
+	if err != nil {
+		return err
+	}
+	artistsBySong := make(map[int64][]int64)
+	for _, l := range artistLinks {
+		artistsBySong[l.SongID] = append(artistsBySong[l.SongID], l.ArtistID)
+	}
+
+	seen := make(map[int64]bool, len(songs))
+	for _, song := range songs {
+		seen[song.Id] = true
+		old, ok := current[song.Id]
+		fields := diffFields(
+			translationFields(nameOf(old.TranslationID), song.Name),
+			field("name", old.Name, song.NameKey),
+			field("lyrics", old.LyricsText, song.LyricsText),
+			field("dances", formatIDs(dancesBySong[song.Id]), formatIDs(song.DanceIds)),
+			field("artists", formatIDs(artistsBySong[song.Id]), formatIDs(song.ArtistIds)),
+			field("file_key", old.FileKey, formatKey(song.FileKey)),
+			field("duration_ms", formatOptional(old.DurationMs), formatOptional(audioValue(song.Audio.DurationMs))),
+			field("bitrate_kbps", formatOptional(old.BitrateKbps), formatOptional(audioValue(song.Audio.BitrateKbps))),
+			field("media_status", old.MediaStatus, MediaStatusToDao(song.MediaStatus)),
+			field("deleted", formatBool(old.DeletedAt.Valid), formatBool(false)),
+		)
+		if old.ModifiedInAdmin {
+			report.skip("song", strconv.FormatInt(song.Id, 10), fields)
+			continue
+		}
+		report.add("song", strconv.FormatInt(song.Id, 10), ok, fields)
+	}
+	for _, r := range existing {
+		if !seen[r.ID] && !r.DeletedAt.Valid && !r.CreatedInAdmin && !r.ModifiedInAdmin {
+			report.remove("song", strconv.FormatInt(r.ID, 10))
+		}
+	}
+	return nil
+}
+
+func (a autoUploadDataService) diffVideos(ctx context.Context, report *DiffReport, videos []domain.VideoShort, nameOf func(pgtype.Int8) domain.Translation) error {
+	existing, err := a.querier.GetVideos(ctx)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]db.GetVideosRow, len(existing))
+	for _, r := range existing {
+		key := domain.NormalizeVideoURL(r.Link)
+		if _, ok := current[key]; !ok {
+			current[key] = r
+		}
+	}
+
+	links, err := a.querier.GetDanceVideos(ctx)
+	if err != nil {
+		return err
+	}
+	dancesByVideo := make(map[int64][]int64)
+	for _, l := range links {
+		dancesByVideo[l.VideoID] = append(dancesByVideo[l.VideoID], l.DanceID)
+	}
+
+	seen := make(map[int64]bool, len(videos))
+	for _, video := range videos {
+		key := domain.NormalizeVideoURL(video.Link)
+		old, ok := current[key]
+		if ok {
+			seen[old.ID] = true
+		}
+		fields := diffFields(
+			translationFields(nameOf(old.TranslationID), video.Name),
+			field("name", old.Name, video.NameKey),
+			field("link", old.Link, video.Link),
+			field("type", old.Type, string(video.Type)),
+			field("dances", formatIDs(dancesByVideo[old.ID]), formatIDs(video.DanceIds)),
+			field("deleted", formatBool(old.DeletedAt.Valid), formatBool(false)),
+		)
+		if old.ModifiedInAdmin {
+			report.skip("video", key, fields)
+			continue
+		}
+		report.add("video", key, ok, fields)
+	}
+	for _, r := range existing {
+		if !seen[r.ID] && !r.DeletedAt.Valid && !r.CreatedInAdmin && !r.ModifiedInAdmin {
+			report.remove("video", domain.NormalizeVideoURL(r.Link))
+		}
+	}
+	return nil
+}
+
+func (a autoUploadDataService) diffGroups(ctx context.Context, report *DiffReport, groups []domain.Group, nameOf func(pgtype.Int8) domain.Translation) error {
+	existing, err := a.querier.GetGroups(ctx)
+	if err != nil {
+		return err
+	}
+	// Группы сопоставляются по армянскому имени так же, как в UpsertGroups
+	current := make(map[string]db.GetGroupsRow, len(existing))
+	for _, r := range existing {
+		if _, ok := current[r.Name]; !ok {
+			current[r.Name] = r
+		}
+	}
+
+	seen := make(map[int64]bool, len(groups))
+	for _, group := range groups {
+		old, ok := current[group.NameKey]
+		if ok {
+			seen[old.ID] = true
+		}
+		fields := diffFields(
+			translationFields(nameOf(old.TranslationID), group.Name),
+			field("name", old.Name, group.NameKey),
+			field("flag", old.Flag, group.Flag),
+			field("country_code", old.CountryCode, group.CountryCode),
+			field("link", old.Link, group.Url),
+			field("type", old.Type, string(group.Type)),
+			field("deleted", formatBool(old.DeletedAt.Valid), formatBool(false)),
+		)
+		report.add("group", group.NameKey, ok, fields)
+	}
+	for _, r := range existing {
+		if !seen[r.ID] && !r.DeletedAt.Valid {
+			report.remove("group", r.Name)
+		}
+	}
+	return nil
+}
+
+// add записывает новую запись или изменённые поля существующей. У новой записи поля
+// сохраняются для журнала изменений, но в текстовом отчёте не печатаются
+func (r *DiffReport) add(entity, key string, exists bool, fields []FieldChange) {
+	switch {
+	case !exists:
+		r.Changes = append(r.Changes, EntityChange{Entity: entity, Key: key, Kind: Added, Fields: fields})
+	case len(fields) > 0:
+		r.Changes = append(r.Changes, EntityChange{Entity: entity, Key: key, Kind: Changed, Fields: fields})
+	}
+}
+
+func (r *DiffReport) remove(entity, key string) {
+	r.Changes = append(r.Changes, EntityChange{Entity: entity, Key: key, Kind: Removed})
+}
+
+// skip записывает отличия, которые загрузка оставит без изменений, чтобы их было видно в отчёте
+func (r *DiffReport) skip(entity, key string, fields []FieldChange) {
+	if len(fields) > 0 {
+		r.Changes = append(r.Changes, EntityChange{Entity: entity, Key: key, Kind: Skipped, Fields: fields})
+	}
+}
+
+// Count возвращает число изменений заданного вида по сущности
+func (r *DiffReport) Count(entity string, kind ChangeKind) int {
+	n := 0
+	for _, c := range r.Changes {
+		if c.Entity == entity && c.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteText печатает отчёт в человекочитаемом виде
+func (r *DiffReport) WriteText(w io.Writer) error {
+	for _, entity := range []string{"region", "artist", "dance", "song", "video", "group"} {
+		if _, err := fmt.Fprintf(w, "%ss: +%d ~%d -%d\n", entity,
+			r.Count(entity, Added), r.Count(entity, Changed), r.Count(entity, Removed)); err != nil {
+			return err
+		}
+		for _, c := range r.Changes {
+			if c.Entity != entity {
+				continue
+			}
+			sign := map[ChangeKind]string{Added: "+", Changed: "~", Removed: "-", Skipped: "!"}[c.Kind]
+			note := ""
+			if c.Kind == Skipped {
+				note = " (edited in admin, left as is)"
+			}
+			if _, err := fmt.Fprintf(w, "  %s %s %s%s\n", sign, entity, c.Key, note); err != nil {
+				return err
+			}
+			if c.Kind != Changed && c.Kind != Skipped {
+				continue
+			}
+			for _, f := range c.Fields {
+				if _, err := fmt.Fprintf(w, "      %s: %q -> %q\n", f.Field, f.Old, f.New); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func field(name, before, after string) FieldChange {
+	return FieldChange{Field: name, Old: before, New: after}
+}
+
+// translationFields сравнивает три языка разом, чтобы в отчёте был виден весь перевод
+func translationFields(before, after domain.Translation) FieldChange {
+	return field("translation", formatTranslation(before), formatTranslation(after))
+}
+
+// diffFields оставляет только действительно изменившиеся поля
+func diffFields(fields ...FieldChange) []FieldChange {
+	var res []FieldChange
+	for _, f := range fields {
+		if f.Old != f.New {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+func formatTranslation(t domain.Translation) string {
+	return fmt.Sprintf("hy=%s en=%s ru=%s", t.ArmName, t.EngName, t.RuName)
+}
+
+func formatBool(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+func formatKey(key *string) string {
+	if key == nil {
+		return ""
+	}
+	return *key
+}
+
+func formatOptional(value pgtype.Int4) string {
+	if !value.Valid {
+		return ""
+	}
+	return strconv.Itoa(int(value.Int32))
+}
+
+// audioValue повторяет NULLIF из UpsertSongs: нуль значит, что данных из файла нет
+func audioValue(n int) pgtype.Int4 {
+	return pgtype.Int4{Int32: int32(n), Valid: n != 0}
+}
+
+// formatPhotoVariants приводит JSONB из базы к тому же виду, что и PhotoVariantsToDao:
+// Postgres хранит ключи объектов в своём порядке
+func formatPhotoVariants(data []byte) string {
+	var variants []domain.PhotoVariant
+	if err := json.Unmarshal(data, &variants); err != nil {
+		return string(data)
+	}
+	return string(PhotoVariantsToDao(variants))
+}
+
+func formatInts(values []int32) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(int(v))
+	}
+	return strings.Join(parts, ",")
+}
+
+// formatIDs печатает набор id без учёта порядка и повторов
+func formatIDs(ids []int64) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+	parts := make([]string, len(sorted))
+	for i, id := range sorted {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,131 @@
+package autoUploadDataService
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	service := NewAutoUploadDataService(querier)
+
+	regions := []domain.Region{
+		{Id: 1, Name: domain.Translation{ArmName: "Շիրակ", EngName: "Shirak", RuName: "Ширак"}},
+		{Id: 2, Name: domain.Translation{ArmName: "Լոռի", EngName: "Lori", RuName: "Лори"}},
+	}
+	require.NoError(t, service.UpsertRegions(ctx, regions))
+	require.NoError(t, service.UpsertVideos(ctx, []domain.VideoShort{
+		{NameKey: "video", Link: "https://youtu.be/a1", Type: domain.Video, DanceIds: []int64{1}},
+	}))
+
+	// Регион 1 переименован, регион 2 удалён, регион 3 новый, видео то же, но с другим типом
+	report, err := service.Diff(ctx, ImportData{
+		Regions: []domain.Region{
+			{Id: 1, Name: domain.Translation{ArmName: "Շիրակ", EngName: "Shirak", RuName: "Ширакская"}},
+			{Id: 3, Name: domain.Translation{ArmName: "Սյունիք"}},
+		},
+		Videos: []domain.VideoShort{
+			{NameKey: "video", Link: "https://www.youtube.com/watch?v=a1", Type: domain.Lesson, DanceIds: []int64{1}},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.Count("region", Added))
+	assert.Equal(t, 1, report.Count("region", Changed))
+	assert.Equal(t, 1, report.Count("region", Removed))
+	assert.Equal(t, 1, report.Count("video", Changed))
+
+	for _, c := range report.Changes {
+		if c.Entity == "region" && c.Kind == Changed {
+			require.Len(t, c.Fields, 1)
+			assert.Equal(t, "translation", c.Fields[0].Field)
+			assert.Equal(t, "hy=Շիրակ en=Shirak ru=Ширакская", c.Fields[0].New)
+		}
+		if c.Entity == "video" {
+			assert.Equal(t, "youtube.com/watch?v=a1", c.Key)
+		}
+	}
+
+	// Dry-run ничего не пишет
+	dbRegions, err := querier.GetRegions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, dbRegions, 2)
+}
+
+func TestDiff_GroupsAndMedia_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	service := NewAutoUploadDataService(querier)
+
+	oldKey, newKey := "old.mp3", "new.mp3"
+	require.NoError(t, service.UpsertSongs(ctx, []domain.SongShort{
+		{Id: 1, NameKey: "song", FileKey: &oldKey, Audio: domain.AudioInfo{DurationMs: 1000}},
+	}))
+	require.NoError(t, service.UpsertGroups(ctx, []domain.Group{
+		{NameKey: "Կարին", Flag: "am", Type: domain.Yerevan},
+		{NameKey: "Մասիս", Flag: "am", Type: domain.Diaspora},
+	}))
+
+	// У песни новая запись, у группы Կարին новая ссылка, Մասիս пропала, Նուռ новая
+	report, err := service.Diff(ctx, ImportData{
+		Songs: []domain.SongShort{
+			{Id: 1, NameKey: "song", FileKey: &newKey, Audio: domain.AudioInfo{DurationMs: 2000}},
+		},
+		Groups: []domain.Group{
+			{NameKey: "Կարին", Flag: "am", Url: "https://karin.am", Type: domain.Yerevan},
+			{NameKey: "Նուռ", Flag: "fr", Type: domain.Diaspora},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.Count("song", Changed))
+	assert.Equal(t, 1, report.Count("group", Added))
+	assert.Equal(t, 1, report.Count("group", Changed))
+	assert.Equal(t, 1, report.Count("group", Removed))
+
+	for _, c := range report.Changes {
+		switch {
+		case c.Entity == "song":
+			assert.Equal(t, []FieldChange{
+				field("file_key", "old.mp3", "new.mp3"),
+				field("duration_ms", "1000", "2000"),
+			}, c.Fields)
+		case c.Entity == "group" && c.Kind == Changed:
+			assert.Equal(t, "Կարին", c.Key)
+			assert.Equal(t, []FieldChange{field("link", "", "https://karin.am")}, c.Fields)
+		case c.Entity == "group" && c.Kind == Removed:
+			assert.Equal(t, "Մասիս", c.Key)
+		}
+	}
+}
+
+func TestDiffReport_WriteText(t *testing.T) {
+	report := &DiffReport{}
+	report.add("dance", "7", false, nil)
+	report.add("dance", "8", true, diffFields(field("gender", "male", "female"), field("paces", "1", "1")))
+	report.add("dance", "9", true, nil)
+	report.remove("song", "3")
+	report.skip("dance", "10", diffFields(field("name", "Berd fixed", "Berd")))
+	report.skip("dance", "11", nil)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteText(&buf))
+
+	out := buf.String()
+	assert.Contains(t, out, "dances: +1 ~1 -0\n")
+	assert.Contains(t, out, "  + dance 7\n")
+	assert.Contains(t, out, "  ~ dance 8\n      gender: \"male\" -> \"female\"\n")
+	assert.NotContains(t, out, "paces")
+	assert.NotContains(t, out, "dance 9")
+	assert.Contains(t, out, "  ! dance 10 (edited in admin, left as is)\n      name: \"Berd fixed\" -> \"Berd\"\n")
+	assert.NotContains(t, out, "dance 11")
+	assert.Contains(t, out, "songs: +0 ~0 -1\n  - song 3\n")
+}
@@ -1,6 +1,7 @@
 package autoUploadDataService
 
 import (
+	"encoding/json"
 	"time"
 
 	db "github.com/Ari-Pari/backend/internal/db/sqlc"
@@ -88,21 +89,41 @@ func DanceToDao(dances []domain.DanceShort, translationIds []int64) []db.InsertD
 				Int64: translationIds[i],
 				Valid: true,
 			},
-			Name:       dance.NameKey,
-			PhotoKey:   photoKey,
-			Paces:      dance.Paces,
-			Gender:     string(dance.Gender),
-			Complexity: complexity,
-			Genres:     genres,
-			DeletedAt:  deletedAt,
-			Handshakes: handshakes,
-			Popularity: 0,
+			Name:          dance.NameKey,
+			PhotoKey:      photoKey,
+			Paces:         dance.Paces,
+			Gender:        string(dance.Gender),
+			Complexity:    complexity,
+			Genres:        genres,
+			DeletedAt:     deletedAt,
+			Handshakes:    handshakes,
+			Popularity:    0,
+			MediaStatus:   MediaStatusToDao(dance.MediaStatus),
+			PhotoVariants: PhotoVariantsToDao(dance.PhotoVariants),
 		}
 	}
 
 	return params
 }
 
+// MediaStatusToDao считает записи без статуса (собранные не парсером) загруженными нормально
+func MediaStatusToDao(status domain.MediaStatus) string {
+	if status == "" {
+		return string(domain.MediaOk)
+	}
+	return string(status)
+}
+
+// PhotoVariantsToDao кодирует варианты фото для колонки JSONB. В PhotoVariant только строки и числа,
+// поэтому Marshal не может вернуть ошибку
+func PhotoVariantsToDao(variants []domain.PhotoVariant) []byte {
+	if variants == nil {
+		variants = []domain.PhotoVariant{}
+	}
+	data, _ := json.Marshal(variants)
+	return data
+}
+
 func DanceRegionsToDao(dances []domain.DanceShort) db.InsertDanceRegionsParams {
 	danceIds := make([]int64, 0, len(dances))
 	regionIds := make([]int64, 0, len(dances))
@@ -124,6 +145,14 @@ func SongsToDao(songs []domain.SongShort, translationIds []int64) db.InsertSongs
 	ids := make([]int64, len(songs))
 	names := make([]string, len(songs))
 	fileKeys := make([]string, len(songs))
+	lyricsHTMLs := make([]string, len(songs))
+	lyricsTexts := make([]string, len(songs))
+	mediaStatuses := make([]string, len(songs))
+	durations := make([]int32, len(songs))
+	bitrates := make([]int32, len(songs))
+	sampleRates := make([]int32, len(songs))
+	tagTitles := make([]string, len(songs))
+	tagArtists := make([]string, len(songs))
 
 	for i := range songs {
 		ids[i] = songs[i].Id
@@ -131,6 +160,15 @@ func SongsToDao(songs []domain.SongShort, translationIds []int64) db.InsertSongs
 		if songs[i].FileKey != nil {
 			fileKeys[i] = *songs[i].FileKey
 		}
+		lyricsHTMLs[i] = songs[i].LyricsHTML
+		lyricsTexts[i] = songs[i].LyricsText
+		mediaStatuses[i] = MediaStatusToDao(songs[i].MediaStatus)
+		// Нули в запросе превращаются в NULL: данных из файла нет
+		durations[i] = int32(songs[i].Audio.DurationMs)
+		bitrates[i] = int32(songs[i].Audio.BitrateKbps)
+		sampleRates[i] = int32(songs[i].Audio.SampleRateHz)
+		tagTitles[i] = songs[i].Audio.Title
+		tagArtists[i] = songs[i].Audio.Artist
 	}
 
 	return db.InsertSongsParams{
@@ -138,6 +176,14 @@ func SongsToDao(songs []domain.SongShort, translationIds []int64) db.InsertSongs
 		TranslationIds: translationIds,
 		Names:          names,
 		FileKeys:       fileKeys,
+		LyricsHtmls:    lyricsHTMLs,
+		LyricsTexts:    lyricsTexts,
+		MediaStatuses:  mediaStatuses,
+		DurationsMs:    durations,
+		BitratesKbps:   bitrates,
+		SampleRatesHz:  sampleRates,
+		TagTitles:      tagTitles,
+		TagArtists:     tagArtists,
 	}
 }
 
@@ -239,3 +285,28 @@ func ArtistsToDao(artists []domain.ArtistShort, translations []int64) db.InsertA
 		DeletedAts:     deletedAts,
 	}
 }
+
+func GroupsToDao(groups []domain.Group, translationIds []int64) db.InsertGroupsParams {
+	names := make([]string, len(groups))
+	flags := make([]string, len(groups))
+	countryCodes := make([]string, len(groups))
+	links := make([]string, len(groups))
+	types := make([]string, len(groups))
+
+	for i, group := range groups {
+		names[i] = group.NameKey
+		flags[i] = group.Flag
+		countryCodes[i] = group.CountryCode
+		links[i] = group.Url
+		types[i] = string(group.Type)
+	}
+
+	return db.InsertGroupsParams{
+		TranslationIds: translationIds,
+		Names:          names,
+		Flags:          flags,
+		CountryCodes:   countryCodes,
+		Links:          links,
+		Types:          types,
+	}
+}
@@ -0,0 +1,138 @@
+package autoUploadDataService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Ari-Pari/backend/internal/audit"
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TxBeginner — источник транзакций, например *pgxpool.Pool
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// ImportStep — именованный шаг загрузки, выполняемый внутри общей транзакции
+type ImportStep struct {
+	Name string
+	Run  func(ctx context.Context, service AutoUploadDataService) error
+}
+
+// ImportError сообщает, на каком шаге и на какой записи источника упала загрузка
+type ImportError struct {
+	Step   string
+	Record string // запись источника, если её удалось определить
+	Err    error
+}
+
+func (e *ImportError) Error() string {
+	if e.Record != "" {
+		return fmt.Sprintf("import step %q failed on %s: %v", e.Step, e.Record, e.Err)
+	}
+	return fmt.Sprintf("import step %q failed: %v", e.Step, e.Err)
+}
+
+func (e *ImportError) Unwrap() error {
+	return e.Err
+}
+
+// RunImport выполняет все шаги в одной транзакции: при ошибке любого шага
+// откатывается всё, включая TruncateAllTables, и в базе остаются прежние данные.
+// Отличия data от базы пишутся в журнал изменений, а изменившиеся танцы — в их версии,
+// той же транзакцией с общим id запуска. Этот id и возвращается
+func RunImport(ctx context.Context, pool TxBeginner, queries *db.Queries, data ImportData, steps []ImportStep) (string, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("begin import transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := queries.WithTx(tx)
+	service := NewAutoUploadDataService(q)
+
+	// Отличия и id видео и групп берутся до шагов: после truncate сравнивать уже не с чем
+	report, err := service.Diff(ctx, data)
+	if err != nil {
+		return "", fmt.Errorf("diff import data: %w", err)
+	}
+	ids, err := entityIDs(ctx, q)
+	if err != nil {
+		return "", fmt.Errorf("get entity ids: %w", err)
+	}
+	// Состояние до загрузки сохраняется без автора у танцев, которых ещё нет в истории
+	if err = snapshotDances(ctx, q, pgtype.Text{}, pgtype.UUID{}); err != nil {
+		return "", fmt.Errorf("snapshot dances: %w", err)
+	}
+
+	for _, step := range steps {
+		if err := step.Run(ctx, service); err != nil {
+			return "", newImportError(step.Name, err)
+		}
+	}
+
+	// Журнал ссылается на строки после загрузки: новые видео и группы получают id только на своих шагах,
+	// а после truncate — и прежние. Id до шагов остаются только у удалённых записей
+	after, err := entityIDs(ctx, q)
+	if err != nil {
+		return "", fmt.Errorf("get entity ids: %w", err)
+	}
+	ids.merge(after)
+	entries, err := auditEntries(report, ids)
+	if err != nil {
+		return "", fmt.Errorf("build audit entries: %w", err)
+	}
+	runID := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	if err = audit.Record(ctx, q, audit.ImportActor, runID, entries...); err != nil {
+		return "", fmt.Errorf("record audit: %w", err)
+	}
+	if err = snapshotDances(ctx, q, pgtype.Text{String: audit.ImportActor, Valid: true}, runID); err != nil {
+		return "", fmt.Errorf("snapshot dances: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("commit import transaction: %w", err)
+	}
+	return runID.String(), nil
+}
+
+// recordError помечает ошибку записью источника, на которой она произошла
+type recordError struct {
+	record string
+	err    error
+}
+
+func (e *recordError) Error() string {
+	return e.record + ": " + e.err.Error()
+}
+
+func (e *recordError) Unwrap() error {
+	return e.err
+}
+
+func withRecord(record string, err error) error {
+	return &recordError{record: record, err: err}
+}
+
+func newImportError(step string, err error) *ImportError {
+	importErr := &ImportError{Step: step, Err: err}
+
+	// Пакетные вставки через unnest не знают номер строки, поэтому для них
+	// запись берётся из Detail ошибки Postgres, например "Key (id)=(5) already exists."
+	var recErr *recordError
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.As(err, &recErr):
+		importErr.Record = recErr.record
+		importErr.Err = recErr.err
+	case errors.As(err, &pgErr) && pgErr.Detail != "":
+		importErr.Record = pgErr.Detail
+	}
+
+	return importErr
+}
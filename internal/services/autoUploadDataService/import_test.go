@@ -0,0 +1,166 @@
+package autoUploadDataService
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImportError(t *testing.T) {
+	cause := errors.New("boom")
+
+	t.Run("Record From Service", func(t *testing.T) {
+		err := newImportError("dances", withRecord("dance id=5", cause))
+
+		assert.Equal(t, "dances", err.Step)
+		assert.Equal(t, "dance id=5", err.Record)
+		assert.ErrorIs(t, err, cause)
+		assert.Equal(t, `import step "dances" failed on dance id=5: boom`, err.Error())
+	})
+
+	t.Run("Record From Postgres Detail", func(t *testing.T) {
+		pgErr := &pgconn.PgError{Code: "23505", Message: "duplicate key", Detail: "Key (id)=(7) already exists."}
+		err := newImportError("songs", pgErr)
+
+		assert.Equal(t, "Key (id)=(7) already exists.", err.Record)
+		assert.ErrorIs(t, err, pgErr)
+	})
+
+	t.Run("Unknown Record", func(t *testing.T) {
+		err := newImportError("videos", cause)
+
+		assert.Empty(t, err.Record)
+		assert.Equal(t, `import step "videos" failed: boom`, err.Error())
+	})
+}
+
+func TestRunImport_RollbackOnFailure_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	regions := []domain.Region{{Id: 1, Name: domain.Translation{ArmName: "Շիրակ"}}}
+	stepErr := errors.New("songs are broken")
+
+	_, err := RunImport(ctx, pool, db.New(pool), ImportData{Regions: regions}, []ImportStep{
+		{Name: "regions", Run: func(ctx context.Context, s AutoUploadDataService) error {
+			return s.UpsertRegions(ctx, regions)
+		}},
+		{Name: "songs", Run: func(ctx context.Context, s AutoUploadDataService) error {
+			return stepErr
+		}},
+	})
+
+	var importErr *ImportError
+	require.ErrorAs(t, err, &importErr)
+	assert.Equal(t, "songs", importErr.Step)
+	assert.ErrorIs(t, err, stepErr)
+
+	// Успешный шаг regions откатился вместе с упавшим
+	dbRegions, err := querier.GetRegions(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, dbRegions)
+
+	runID, err := RunImport(ctx, pool, db.New(pool), ImportData{Regions: regions}, []ImportStep{
+		{Name: "regions", Run: func(ctx context.Context, s AutoUploadDataService) error {
+			return s.UpsertRegions(ctx, regions)
+		}},
+	})
+	require.NoError(t, err)
+
+	dbRegions, err = querier.GetRegions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, dbRegions, 1)
+
+	// Каждая затронутая строка попадает в журнал с id запуска
+	var importRunID pgtype.UUID
+	require.NoError(t, importRunID.Scan(runID))
+	entries, err := querier.ListAuditLog(ctx, db.ListAuditLogParams{ImportRunID: importRunID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "autoupload", entries[0].Actor)
+	assert.Equal(t, "region", entries[0].EntityType)
+	assert.Equal(t, int64(1), entries[0].EntityID)
+	assert.Equal(t, "create", entries[0].Action)
+	assert.JSONEq(t, `{"translation": {"old": null, "new": "hy=Շիրակ en= ru="}, "name": {"old": null, "new": "Շիրակ"}}`, string(entries[0].Diff))
+
+	// Группы в источнике без id: в журнал попадает id, который группа получила при загрузке
+	groups := []domain.Group{{NameKey: "Կարին", Flag: "am", Type: domain.Yerevan}}
+	runID, err = RunImport(ctx, pool, db.New(pool), ImportData{Regions: regions, Groups: groups}, []ImportStep{
+		{Name: "regions", Run: func(ctx context.Context, s AutoUploadDataService) error {
+			return s.UpsertRegions(ctx, regions)
+		}},
+		{Name: "groups", Run: func(ctx context.Context, s AutoUploadDataService) error {
+			return s.UpsertGroups(ctx, groups)
+		}},
+	})
+	require.NoError(t, err)
+
+	dbGroups, err := querier.GetGroups(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbGroups, 1)
+	require.NoError(t, importRunID.Scan(runID))
+	entries, err = querier.ListAuditLog(ctx, db.ListAuditLogParams{ImportRunID: importRunID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "group", entries[0].EntityType)
+	assert.Equal(t, dbGroups[0].ID, entries[0].EntityID)
+	assert.Equal(t, "create", entries[0].Action)
+}
+
+func TestKeyIDs_Merge(t *testing.T) {
+	ids := keyIDs{"video": {"youtu.be/a": 1, "youtu.be/b": 2}}
+
+	ids.merge(keyIDs{"video": {"youtu.be/b": 7, "youtu.be/c": 8}, "group": {"Կարին": 3}})
+
+	assert.Equal(t, keyIDs{
+		"video": {"youtu.be/a": 1, "youtu.be/b": 7, "youtu.be/c": 8},
+		"group": {"Կարին": 3},
+	}, ids)
+}
+
+func TestRunImport_TruncateAuditsNewIDs_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	videos := []domain.VideoShort{
+		{NameKey: "video", Link: "https://youtu.be/Wk61XUxP2Mg", Type: domain.Video},
+		{NameKey: "lesson", Link: "https://youtu.be/dQw4w9WgXcQ", Type: domain.Lesson},
+	}
+	require.NoError(t, NewAutoUploadDataService(querier).CreateVideos(ctx, videos))
+
+	// После truncate id начинаются заново: урок получает id, который раньше был у первого видео
+	videos = []domain.VideoShort{{NameKey: "lesson fixed", Link: "https://youtu.be/dQw4w9WgXcQ", Type: domain.Lesson}}
+	runID, err := RunImport(ctx, pool, db.New(pool), ImportData{Videos: videos}, []ImportStep{
+		{Name: "truncate", Run: func(ctx context.Context, s AutoUploadDataService) error {
+			return s.ClearAllTables(ctx)
+		}},
+		{Name: "videos", Run: func(ctx context.Context, s AutoUploadDataService) error {
+			return s.CreateVideos(ctx, videos)
+		}},
+	})
+	require.NoError(t, err)
+
+	dbVideos, err := querier.GetVideos(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbVideos, 1)
+
+	var importRunID pgtype.UUID
+	require.NoError(t, importRunID.Scan(runID))
+	entries, err := querier.ListAuditLog(ctx, db.ListAuditLogParams{ImportRunID: importRunID, Limit: 10})
+	require.NoError(t, err)
+	var updated int
+	for _, entry := range entries {
+		if entry.Action == "update" {
+			assert.Equal(t, dbVideos[0].ID, entry.EntityID)
+			updated++
+		}
+	}
+	assert.Equal(t, 1, updated)
+}
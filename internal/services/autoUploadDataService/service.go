@@ -2,6 +2,7 @@ package autoUploadDataService
 
 import (
 	"context"
+	"fmt"
 
 	db "github.com/Ari-Pari/backend/internal/db/sqlc"
 	"github.com/Ari-Pari/backend/internal/domain"
@@ -14,6 +15,14 @@ type AutoUploadDataService interface {
 	CreateRegions(ctx context.Context, regions []domain.Region) error
 	CreateSongs(ctx context.Context, songs []domain.SongShort) error
 	CreateVideos(ctx context.Context, videos []domain.VideoShort) error
+	CreateGroups(ctx context.Context, groups []domain.Group) error
+	UpsertArtists(ctx context.Context, artists []domain.ArtistShort) error
+	UpsertDances(ctx context.Context, dances []domain.DanceShort) error
+	UpsertRegions(ctx context.Context, regions []domain.Region) error
+	UpsertSongs(ctx context.Context, songs []domain.SongShort) error
+	UpsertVideos(ctx context.Context, videos []domain.VideoShort) error
+	UpsertGroups(ctx context.Context, groups []domain.Group) error
+	Diff(ctx context.Context, data ImportData) (*DiffReport, error)
 }
 
 type autoUploadDataService struct {
@@ -59,6 +68,21 @@ func (a autoUploadDataService) CreateVideos(ctx context.Context, videos []domain
 	return a.querier.InsertDanceVideos(ctx, danceVideoToParams)
 }
 
+func (a autoUploadDataService) CreateGroups(ctx context.Context, groups []domain.Group) error {
+	translations := make([]domain.Translation, len(groups))
+	for i := range groups {
+		translations[i] = groups[i].Name
+	}
+
+	translationToParams := TranslationToDao(translations)
+	translationIds, err := a.querier.InsertTranslations(ctx, translationToParams)
+	if err != nil {
+		return err
+	}
+
+	return a.querier.InsertGroups(ctx, GroupsToDao(groups, translationIds))
+}
+
 func (a autoUploadDataService) CreateSongs(ctx context.Context, songs []domain.SongShort) error {
 	translations := make([]domain.Translation, len(songs))
 	for i := range songs {
@@ -116,7 +140,7 @@ func (a autoUploadDataService) CreateDances(ctx context.Context, dances []domain
 	dancesToParams := DanceToDao(dances, translationIds)
 	for _, dance := range dancesToParams {
 		if err = a.querier.InsertDance(ctx, dance); err != nil {
-			return err
+			return withRecord(fmt.Sprintf("dance id=%d", dance.ID), err)
 		}
 	}
 
@@ -134,6 +134,12 @@ func TestCreateSongs_Integration(t *testing.T) {
 			},
 			DanceIds: []int64{1, 2},
 		},
+		{
+			Id:          2,
+			NameKey:     "song.missing",
+			Name:        domain.Translation{ArmName: "Երգ"},
+			MediaStatus: domain.MediaMissing,
+		},
 	}
 
 	err := service.CreateSongs(context.Background(), songs)
@@ -142,6 +148,12 @@ func TestCreateSongs_Integration(t *testing.T) {
 	dbSongs, err := querier.GetSongs(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, dbSongs, len(songs))
+
+	statuses := make(map[int64]string, len(dbSongs))
+	for _, song := range dbSongs {
+		statuses[song.ID] = song.MediaStatus
+	}
+	assert.Equal(t, map[int64]string{1: "OK", 2: "MISSING"}, statuses)
 }
 
 func TestCreateVideos_Integration(t *testing.T) {
@@ -172,6 +184,35 @@ func TestCreateVideos_Integration(t *testing.T) {
 	assert.Len(t, dbVideos, len(videos))
 }
 
+func TestCreateGroups_Integration(t *testing.T) {
+	resetDB(t)
+
+	service := NewAutoUploadDataService(querier)
+
+	groups := []domain.Group{
+		{
+			Id:      nil,
+			NameKey: "Արարատ",
+			Name: domain.Translation{
+				ArmName: "Արարատ",
+				EngName: "Ararat",
+				RuName:  "Арарат",
+			},
+			Flag:        "🇦🇷",
+			CountryCode: "AR",
+			Url:         "https://example.com/ararat",
+			Type:        domain.Diaspora,
+		},
+	}
+
+	err := service.CreateGroups(context.Background(), groups)
+	require.NoError(t, err)
+
+	dbGroups, err := querier.GetGroups(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, dbGroups, len(groups))
+}
+
 func TestClearAllTables_Integration(t *testing.T) {
 	resetDB(t)
 
@@ -0,0 +1,411 @@
+package autoUploadDataService
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Upsert-методы обновляют данные на месте вместо TruncateAllTables:
+// строки сопоставляются по id из исходных файлов (видео — по нормализованной ссылке, группы — по имени),
+// новые добавляются, изменившиеся обновляются, пропавшие из источника помечаются deleted_at.
+// popularity и created_at при этом не трогаются, id переводов и видео сохраняются.
+// Записи, созданные в админке, и танцы, которые в ней правили, источник не перезаписывает.
+
+func (a autoUploadDataService) UpsertRegions(ctx context.Context, regions []domain.Region) error {
+	existing, err := a.querier.GetRegions(ctx)
+	if err != nil {
+		return err
+	}
+	translationByID := make(map[int64]pgtype.Int8, len(existing))
+	for _, region := range existing {
+		translationByID[region.ID] = region.TranslationID
+	}
+
+	translations := make([]domain.Translation, len(regions))
+	current := make([]pgtype.Int8, len(regions))
+	for i := range regions {
+		translations[i] = regions[i].Name
+		current[i] = translationByID[regions[i].Id]
+	}
+	translationIds, err := a.syncTranslations(ctx, translations, current)
+	if err != nil {
+		return err
+	}
+
+	params := RegionToDao(regions, translationIds)
+	if err = a.querier.UpsertRegions(ctx, db.UpsertRegionsParams(params)); err != nil {
+		return err
+	}
+
+	return a.querier.SoftDeleteMissingRegions(ctx, params.Ids)
+}
+
+func (a autoUploadDataService) UpsertArtists(ctx context.Context, artists []domain.ArtistShort) error {
+	existing, err := a.querier.GetArtists(ctx)
+	if err != nil {
+		return err
+	}
+	translationByID := make(map[int64]pgtype.Int8, len(existing))
+	for _, artist := range existing {
+		translationByID[artist.ID] = artist.TranslationID
+	}
+
+	translations := make([]domain.Translation, len(artists))
+	current := make([]pgtype.Int8, len(artists))
+	for i := range artists {
+		translations[i] = artists[i].Name
+		current[i] = translationByID[artists[i].Id]
+	}
+	translationIds, err := a.syncTranslations(ctx, translations, current)
+	if err != nil {
+		return err
+	}
+
+	params := ArtistsToDao(artists, translationIds)
+	if err = a.querier.UpsertArtists(ctx, db.UpsertArtistsParams(params)); err != nil {
+		return err
+	}
+
+	return a.querier.SoftDeleteMissingArtists(ctx, params.Ids)
+}
+
+func (a autoUploadDataService) UpsertDances(ctx context.Context, dances []domain.DanceShort) error {
+	existing, err := a.querier.GetDances(ctx)
+	if err != nil {
+		return err
+	}
+	translationByID := make(map[int64]pgtype.Int8, len(existing))
+	modifiedInAdmin := make(map[int64]bool)
+	for _, dance := range existing {
+		translationByID[dance.ID] = dance.TranslationID
+		if dance.ModifiedInAdmin {
+			modifiedInAdmin[dance.ID] = true
+		}
+	}
+	// Правка редактора важнее dances.json: такие танцы вместе с переводами и регионами импорт не трогает
+	dances = slices.DeleteFunc(slices.Clone(dances), func(dance domain.DanceShort) bool {
+		return modifiedInAdmin[dance.Id]
+	})
+
+	translations := make([]domain.Translation, len(dances))
+	current := make([]pgtype.Int8, len(dances))
+	for i := range dances {
+		translations[i] = dances[i].Name
+		current[i] = translationByID[dances[i].Id]
+	}
+	translationIds, err := a.syncTranslations(ctx, translations, current)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int64, len(dances))
+	for i, dance := range DanceToDao(dances, translationIds) {
+		ids[i] = dance.ID
+		err = a.querier.UpsertDance(ctx, db.UpsertDanceParams{
+			ID:            dance.ID,
+			TranslationID: dance.TranslationID,
+			Name:          dance.Name,
+			PhotoKey:      dance.PhotoKey,
+			Complexity:    dance.Complexity,
+			Gender:        dance.Gender,
+			Paces:         dance.Paces,
+			Genres:        dance.Genres,
+			Handshakes:    dance.Handshakes,
+			DeletedAt:     dance.DeletedAt,
+			MediaStatus:   dance.MediaStatus,
+			PhotoVariants: dance.PhotoVariants,
+		})
+		if err != nil {
+			return withRecord(fmt.Sprintf("dance id=%d", dance.ID), err)
+		}
+	}
+
+	if err = a.querier.SoftDeleteMissingDances(ctx, ids); err != nil {
+		return err
+	}
+
+	danceRegions := DanceRegionsToDao(dances)
+	if err = a.querier.InsertDanceRegions(ctx, danceRegions); err != nil {
+		return err
+	}
+
+	return a.querier.DeleteStaleDanceRegions(ctx, db.DeleteStaleDanceRegionsParams(danceRegions))
+}
+
+func (a autoUploadDataService) UpsertSongs(ctx context.Context, songs []domain.SongShort) error {
+	existing, err := a.querier.GetSongs(ctx)
+	if err != nil {
+		return err
+	}
+	translationByID := make(map[int64]pgtype.Int8, len(existing))
+	modifiedInAdmin := make(map[int64]bool)
+	for _, song := range existing {
+		translationByID[song.ID] = song.TranslationID
+		if song.ModifiedInAdmin {
+			modifiedInAdmin[song.ID] = true
+		}
+	}
+	// Как и у танцев: песню, которую правили в админке, импорт не трогает вместе с переводом и связями
+	songs = slices.DeleteFunc(slices.Clone(songs), func(song domain.SongShort) bool {
+		return modifiedInAdmin[song.Id]
+	})
+
+	translations := make([]domain.Translation, len(songs))
+	current := make([]pgtype.Int8, len(songs))
+	for i := range songs {
+		translations[i] = songs[i].Name
+		current[i] = translationByID[songs[i].Id]
+	}
+	translationIds, err := a.syncTranslations(ctx, translations, current)
+	if err != nil {
+		return err
+	}
+
+	params := SongsToDao(songs, translationIds)
+	if err = a.querier.UpsertSongs(ctx, db.UpsertSongsParams(params)); err != nil {
+		return err
+	}
+	if err = a.querier.SoftDeleteMissingSongs(ctx, params.Ids); err != nil {
+		return err
+	}
+
+	skipDances, err := a.modifiedDances(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range songs {
+		songs[i].DanceIds = withoutDances(songs[i].DanceIds, skipDances)
+	}
+	danceSongs := SongDancesToDao(songs)
+	if err = a.querier.InsertDanceSongs(ctx, danceSongs); err != nil {
+		return err
+	}
+	if err = a.querier.DeleteStaleDanceSongs(ctx, db.DeleteStaleDanceSongsParams(danceSongs)); err != nil {
+		return err
+	}
+
+	songArtists := SongArtistsToDao(songs)
+	if err = a.querier.InsertSongArtists(ctx, songArtists); err != nil {
+		return err
+	}
+
+	return a.querier.DeleteStaleSongArtists(ctx, db.DeleteStaleSongArtistsParams(songArtists))
+}
+
+func (a autoUploadDataService) UpsertVideos(ctx context.Context, videos []domain.VideoShort) error {
+	existing, err := a.querier.GetVideos(ctx)
+	if err != nil {
+		return err
+	}
+	// При дублях в базе берётся первая строка, остальные скроет SoftDeleteMissingVideos
+	existingByURL := make(map[string]db.GetVideosRow, len(existing))
+	for _, video := range existing {
+		key := domain.NormalizeVideoURL(video.Link)
+		if _, ok := existingByURL[key]; !ok {
+			existingByURL[key] = video
+		}
+	}
+	// Видео, которое правили в админке, импорт не трогает вместе с переводом и связями
+	videos = slices.DeleteFunc(slices.Clone(videos), func(video domain.VideoShort) bool {
+		return existingByURL[domain.NormalizeVideoURL(video.Link)].ModifiedInAdmin
+	})
+
+	translations := make([]domain.Translation, len(videos))
+	current := make([]pgtype.Int8, len(videos))
+	var matched, added []int
+	var matchedIds []int64
+	for i := range videos {
+		translations[i] = videos[i].Name
+		if video, ok := existingByURL[domain.NormalizeVideoURL(videos[i].Link)]; ok {
+			current[i] = video.TranslationID
+			matched = append(matched, i)
+			matchedIds = append(matchedIds, video.ID)
+		} else {
+			added = append(added, i)
+		}
+	}
+	translationIds, err := a.syncTranslations(ctx, translations, current)
+	if err != nil {
+		return err
+	}
+
+	videoIds := make([]int64, len(videos))
+
+	if len(matched) > 0 {
+		params := VideosToDao(pick(videos, matched), pick(translationIds, matched))
+		err = a.querier.UpdateVideos(ctx, db.UpdateVideosParams{
+			Ids:            matchedIds,
+			Links:          params.Links,
+			TranslationIds: params.TranslationIds,
+			Names:          params.Names,
+			Types:          params.Types,
+		})
+		if err != nil {
+			return err
+		}
+		for j, i := range matched {
+			videoIds[i] = matchedIds[j]
+		}
+	}
+
+	if len(added) > 0 {
+		addedIds, err := a.querier.InsertVideos(ctx, VideosToDao(pick(videos, added), pick(translationIds, added)))
+		if err != nil {
+			return err
+		}
+		for j, i := range added {
+			videoIds[i] = addedIds[j]
+		}
+	}
+
+	if err = a.querier.SoftDeleteMissingVideos(ctx, videoIds); err != nil {
+		return err
+	}
+
+	skipDances, err := a.modifiedDances(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range videos {
+		videos[i].DanceIds = withoutDances(videos[i].DanceIds, skipDances)
+	}
+	danceVideos := DanceVideosToDao(videos, videoIds)
+	if err = a.querier.InsertDanceVideos(ctx, danceVideos); err != nil {
+		return err
+	}
+
+	return a.querier.DeleteStaleDanceVideos(ctx, db.DeleteStaleDanceVideosParams(danceVideos))
+}
+
+func (a autoUploadDataService) UpsertGroups(ctx context.Context, groups []domain.Group) error {
+	existing, err := a.querier.GetGroups(ctx)
+	if err != nil {
+		return err
+	}
+	// В groups.json нет id, а ссылки у разных групп совпадают, поэтому ключ — армянское имя
+	existingByName := make(map[string]db.GetGroupsRow, len(existing))
+	for _, group := range existing {
+		if _, ok := existingByName[group.Name]; !ok {
+			existingByName[group.Name] = group
+		}
+	}
+
+	translations := make([]domain.Translation, len(groups))
+	current := make([]pgtype.Int8, len(groups))
+	var matched, added []int
+	matchedIds := []int64{} // nil ушёл бы в запрос как NULL, и <> ALL ничего бы не скрыл
+	for i := range groups {
+		translations[i] = groups[i].Name
+		if group, ok := existingByName[groups[i].NameKey]; ok {
+			current[i] = group.TranslationID
+			matched = append(matched, i)
+			matchedIds = append(matchedIds, group.ID)
+		} else {
+			added = append(added, i)
+		}
+	}
+	translationIds, err := a.syncTranslations(ctx, translations, current)
+	if err != nil {
+		return err
+	}
+
+	if len(matched) > 0 {
+		params := GroupsToDao(pick(groups, matched), pick(translationIds, matched))
+		err = a.querier.UpdateGroups(ctx, db.UpdateGroupsParams{
+			Ids:            matchedIds,
+			TranslationIds: params.TranslationIds,
+			Flags:          params.Flags,
+			CountryCodes:   params.CountryCodes,
+			Links:          params.Links,
+			Types:          params.Types,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = a.querier.SoftDeleteMissingGroups(ctx, matchedIds); err != nil {
+		return err
+	}
+
+	if len(added) == 0 {
+		return nil
+	}
+	return a.querier.InsertGroups(ctx, GroupsToDao(pick(groups, added), pick(translationIds, added)))
+}
+
+// syncTranslations обновляет уже существующие переводы и создаёт недостающие.
+// Возвращает id переводов в порядке translations
+func (a autoUploadDataService) syncTranslations(ctx context.Context, translations []domain.Translation, current []pgtype.Int8) ([]int64, error) {
+	ids := make([]int64, len(translations))
+	var updated, added []int
+	for i := range translations {
+		if current[i].Valid {
+			ids[i] = current[i].Int64
+			updated = append(updated, i)
+		} else {
+			added = append(added, i)
+		}
+	}
+
+	if len(updated) > 0 {
+		params := TranslationToDao(pick(translations, updated))
+		err := a.querier.UpdateTranslations(ctx, db.UpdateTranslationsParams{
+			Ids:      pick(ids, updated),
+			EngNames: params.EngNames,
+			RuNames:  params.RuNames,
+			ArmNames: params.ArmNames,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(added) > 0 {
+		addedIds, err := a.querier.InsertTranslations(ctx, TranslationToDao(pick(translations, added)))
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range added {
+			ids[i] = addedIds[j]
+		}
+	}
+
+	return ids, nil
+}
+
+// pick возвращает элементы items с индексами indexes
+// modifiedDances возвращает танцы, которые правили в админке. Их связи с песнями и видео импорт
+// не меняет, как и сами танцы
+func (a autoUploadDataService) modifiedDances(ctx context.Context) (map[int64]bool, error) {
+	dances, err := a.querier.GetDances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	modified := make(map[int64]bool)
+	for _, dance := range dances {
+		if dance.ModifiedInAdmin {
+			modified[dance.ID] = true
+		}
+	}
+	return modified, nil
+}
+
+func withoutDances(danceIds []int64, skip map[int64]bool) []int64 {
+	return slices.DeleteFunc(slices.Clone(danceIds), func(id int64) bool {
+		return skip[id]
+	})
+}
+
+func pick[T any](items []T, indexes []int) []T {
+	res := make([]T, len(indexes))
+	for j, i := range indexes {
+		res[j] = items[i]
+	}
+	return res
+}
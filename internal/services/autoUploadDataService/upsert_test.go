@@ -0,0 +1,340 @@
+package autoUploadDataService
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/Ari-Pari/backend/internal/db/sqlc"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/Ari-Pari/backend/internal/services/adminService"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertDances_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	service := NewAutoUploadDataService(querier)
+
+	dance := func(id int64, engName string) domain.DanceShort {
+		return domain.DanceShort{
+			Id:        id,
+			NameKey:   engName,
+			Name:      domain.Translation{ArmName: engName, EngName: engName, RuName: engName},
+			Gender:    domain.Male,
+			Paces:     []int32{1},
+			RegionIds: []int64{1},
+		}
+	}
+
+	err := service.UpsertDances(ctx, []domain.DanceShort{dance(1, "Shirak"), dance(2, "Lori")})
+	require.NoError(t, err)
+
+	// Накручиваем популярность, она должна пережить повторный импорт
+	require.NoError(t, querier.IncrementDancePopularity(ctx, 1))
+
+	before, err := querier.GetDances(ctx)
+	require.NoError(t, err)
+	require.Len(t, before, 2)
+	translationID := before[0].TranslationID
+	if before[0].ID != 1 {
+		translationID = before[1].TranslationID
+	}
+
+	// Повторный импорт: танец 1 переименован, танца 2 в источнике больше нет
+	err = service.UpsertDances(ctx, []domain.DanceShort{dance(1, "Shirak new")})
+	require.NoError(t, err)
+
+	after, err := querier.GetDances(ctx)
+	require.NoError(t, err)
+	require.Len(t, after, 2)
+
+	byID := make(map[int64]int)
+	for i, d := range after {
+		byID[d.ID] = i
+	}
+	updated := after[byID[1]]
+	assert.Equal(t, "Shirak new", updated.Name)
+	assert.Equal(t, int32(1), updated.Popularity)
+	assert.Equal(t, translationID, updated.TranslationID)
+	assert.False(t, updated.DeletedAt.Valid)
+	assert.True(t, after[byID[2]].DeletedAt.Valid)
+
+	translations, err := querier.GetTranslations(ctx)
+	require.NoError(t, err)
+	assert.Len(t, translations, 2)
+
+	danceRegions, err := querier.GetDanceRegions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, danceRegions, 1)
+}
+
+func TestUpsertDances_KeepsAdminEdits_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	service := NewAutoUploadDataService(querier)
+
+	dance := domain.DanceShort{
+		Id:        1,
+		NameKey:   "Shirak",
+		Name:      domain.Translation{ArmName: "Shirak", EngName: "Shirak"},
+		Gender:    domain.Male,
+		Paces:     []int32{1},
+		RegionIds: []int64{1},
+	}
+	require.NoError(t, service.UpsertDances(ctx, []domain.DanceShort{dance}))
+
+	// Редактор поправил название, сменил регион и удалил танец
+	_, err := pool.Exec(ctx, `UPDATE dances SET name = 'Shirak fixed', deleted_at = NOW(), modified_in_admin = TRUE WHERE id = 1`)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `UPDATE dance_region SET region_id = 2 WHERE dance_id = 1`)
+	require.NoError(t, err)
+
+	dance.Name.RuName = "Ширак"
+	report, err := service.Diff(ctx, ImportData{Dances: []domain.DanceShort{dance}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Count("dance", Changed))
+	assert.Equal(t, 1, report.Count("dance", Skipped))
+
+	require.NoError(t, service.UpsertDances(ctx, []domain.DanceShort{dance}))
+
+	dances, err := querier.GetDances(ctx)
+	require.NoError(t, err)
+	require.Len(t, dances, 1)
+	assert.Equal(t, "Shirak fixed", dances[0].Name)
+	assert.True(t, dances[0].DeletedAt.Valid)
+
+	translations, err := querier.GetTranslations(ctx)
+	require.NoError(t, err)
+	require.Len(t, translations, 1)
+	assert.Empty(t, translations[0].RuName.String)
+
+	danceRegions, err := querier.GetDanceRegions(ctx)
+	require.NoError(t, err)
+	require.Len(t, danceRegions, 1)
+	assert.Equal(t, int64(2), danceRegions[0].RegionID)
+}
+
+func TestUpsertSongs_KeepsAdminEdits_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	service := NewAutoUploadDataService(querier)
+	admin := adminService.NewAdminService(pool, db.New(pool), nil)
+
+	require.NoError(t, service.UpsertDances(ctx, []domain.DanceShort{
+		{Id: 1, NameKey: "Shirak", Name: domain.Translation{ArmName: "Շիրակ"}, Gender: domain.Male},
+		{Id: 2, NameKey: "Berd", Name: domain.Translation{ArmName: "Բերդ"}, Gender: domain.Male},
+	}))
+	songs := []domain.SongShort{
+		{Id: 10, NameKey: "Shirak", Name: domain.Translation{ArmName: "Շիրակ"}, DanceIds: []int64{1}},
+		{Id: 11, NameKey: "Berd", Name: domain.Translation{ArmName: "Բերդ"}, DanceIds: []int64{2}},
+	}
+	require.NoError(t, service.UpsertSongs(ctx, songs))
+
+	// Редактор поправил название первой песни и перенёс её к другому танцу, вторую удалил
+	_, err := admin.UpdateSong(ctx, 10, func(song *domain.SongShort) {
+		song.NameKey = "Shirak fixed"
+		song.DanceIds = []int64{2}
+	})
+	require.NoError(t, err)
+	require.NoError(t, admin.DeleteSong(ctx, 11))
+
+	songs[0].Name.RuName = "Ширак"
+	songs[1].LyricsText = "new lyrics"
+	report, err := service.Diff(ctx, ImportData{Songs: songs})
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Count("song", Changed))
+	assert.Equal(t, 2, report.Count("song", Skipped))
+
+	require.NoError(t, service.UpsertSongs(ctx, songs))
+
+	after, err := querier.GetSongs(ctx)
+	require.NoError(t, err)
+	require.Len(t, after, 2)
+	for _, song := range after {
+		switch song.ID {
+		case 10:
+			assert.Equal(t, "Shirak fixed", song.Name)
+			assert.False(t, song.DeletedAt.Valid)
+		case 11:
+			assert.Empty(t, song.LyricsText)
+			assert.True(t, song.DeletedAt.Valid)
+		}
+	}
+
+	danceSongs, err := querier.GetDanceSongs(ctx)
+	require.NoError(t, err)
+	links := make(map[int64][]int64)
+	for _, l := range danceSongs {
+		links[l.SongID] = append(links[l.SongID], l.DanceID)
+	}
+	assert.Equal(t, []int64{2}, links[10])
+	assert.Equal(t, []int64{2}, links[11])
+}
+
+func TestUpsertVideos_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	service := NewAutoUploadDataService(querier)
+
+	videos := []domain.VideoShort{
+		{NameKey: "video", Link: "https://youtu.be/a1", Type: domain.Video, DanceIds: []int64{1}},
+		{NameKey: "lesson", Link: "https://youtu.be/b2", Type: domain.Lesson, DanceIds: []int64{1}},
+	}
+	require.NoError(t, service.UpsertVideos(ctx, videos))
+
+	before, err := querier.GetVideos(ctx)
+	require.NoError(t, err)
+	require.Len(t, before, 2)
+	videoID := before[0].ID
+	if before[0].Link != "https://youtu.be/a1" {
+		videoID = before[1].ID
+	}
+
+	// Та же ссылка в другом написании остаётся тем же видео, второе видео пропало из источника
+	videos = []domain.VideoShort{
+		{NameKey: "video", Link: "https://www.youtube.com/watch?v=a1", Type: domain.Video, DanceIds: []int64{2}},
+	}
+	require.NoError(t, service.UpsertVideos(ctx, videos))
+
+	after, err := querier.GetVideos(ctx)
+	require.NoError(t, err)
+	require.Len(t, after, 2)
+
+	var visible int
+	for _, v := range after {
+		if v.Link == "https://www.youtube.com/watch?v=a1" {
+			assert.Equal(t, videoID, v.ID)
+			visible++
+		}
+	}
+	assert.Equal(t, 1, visible)
+
+	danceVideos, err := querier.GetDanceVideos(ctx)
+	require.NoError(t, err)
+	require.Len(t, danceVideos, 1)
+	assert.Equal(t, int64(2), danceVideos[0].DanceID)
+}
+
+func TestUpsertVideos_KeepsAdminEdits_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	service := NewAutoUploadDataService(querier)
+	admin := adminService.NewAdminService(pool, db.New(pool), nil)
+
+	require.NoError(t, service.UpsertDances(ctx, []domain.DanceShort{
+		{Id: 1, NameKey: "Shirak", Name: domain.Translation{ArmName: "Շիրակ"}, Gender: domain.Male},
+		{Id: 2, NameKey: "Berd", Name: domain.Translation{ArmName: "Բերդ"}, Gender: domain.Male},
+	}))
+	videos := []domain.VideoShort{
+		{NameKey: "video", Name: domain.Translation{ArmName: "Տեսանյութ"}, Link: "https://youtu.be/Wk61XUxP2Mg", Type: domain.Video, DanceIds: []int64{1}},
+		{NameKey: "lesson", Name: domain.Translation{ArmName: "Դաս"}, Link: "https://youtu.be/dQw4w9WgXcQ", Type: domain.Lesson, DanceIds: []int64{1}},
+	}
+	require.NoError(t, service.UpsertVideos(ctx, videos))
+
+	before, err := querier.GetVideos(ctx)
+	require.NoError(t, err)
+	ids := make(map[string]int64, len(before))
+	for _, v := range before {
+		ids[v.Link] = v.ID
+	}
+
+	// Редактор переименовал первое видео и перенёс его к другому танцу, второе удалил
+	_, err = admin.UpdateVideo(ctx, ids["https://youtu.be/Wk61XUxP2Mg"], func(video *domain.VideoShort) {
+		video.NameKey = "video fixed"
+		video.DanceIds = []int64{2}
+	})
+	require.NoError(t, err)
+	require.NoError(t, admin.DeleteVideo(ctx, ids["https://youtu.be/dQw4w9WgXcQ"]))
+
+	videos[0].Name.RuName = "Видео"
+	videos[1].Type = domain.Video
+	report, err := service.Diff(ctx, ImportData{Videos: videos})
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Count("video", Changed))
+	assert.Equal(t, 2, report.Count("video", Skipped))
+
+	require.NoError(t, service.UpsertVideos(ctx, videos))
+
+	after, err := querier.GetVideos(ctx)
+	require.NoError(t, err)
+	require.Len(t, after, 2)
+	for _, v := range after {
+		switch v.ID {
+		case ids["https://youtu.be/Wk61XUxP2Mg"]:
+			assert.Equal(t, "video fixed", v.Name)
+			assert.False(t, v.DeletedAt.Valid)
+		case ids["https://youtu.be/dQw4w9WgXcQ"]:
+			assert.Equal(t, string(domain.Lesson), v.Type)
+			assert.True(t, v.DeletedAt.Valid)
+		}
+	}
+
+	danceVideos, err := querier.GetDanceVideos(ctx)
+	require.NoError(t, err)
+	links := make(map[int64][]int64)
+	for _, l := range danceVideos {
+		links[l.VideoID] = append(links[l.VideoID], l.DanceID)
+	}
+	assert.Equal(t, []int64{2}, links[ids["https://youtu.be/Wk61XUxP2Mg"]])
+	assert.Equal(t, []int64{1}, links[ids["https://youtu.be/dQw4w9WgXcQ"]])
+}
+
+func TestUpsertSongsAndVideos_KeepRestoredDanceLinks_Integration(t *testing.T) {
+	resetDB(t)
+	ctx := context.Background()
+
+	service := NewAutoUploadDataService(querier)
+	admin := adminService.NewAdminService(pool, db.New(pool), nil)
+
+	require.NoError(t, service.UpsertDances(ctx, []domain.DanceShort{
+		{Id: 1, NameKey: "Shirak", Name: domain.Translation{ArmName: "Շիրակ"}, Gender: domain.Male},
+		{Id: 2, NameKey: "Berd", Name: domain.Translation{ArmName: "Բերդ"}, Gender: domain.Male},
+	}))
+	require.NoError(t, service.UpsertSongs(ctx, []domain.SongShort{
+		{Id: 10, NameKey: "Shirak", DanceIds: []int64{1}},
+	}))
+	require.NoError(t, service.UpsertVideos(ctx, []domain.VideoShort{
+		{NameKey: "video", Link: "https://youtu.be/Wk61XUxP2Mg", Type: domain.Video, DanceIds: []int64{1}},
+	}))
+
+	// Редактор удалил танец и вернул его из версии до удаления вместе со связями
+	require.NoError(t, admin.DeleteDance(ctx, 1))
+	revisions, err := admin.ListDanceRevisions(ctx, 1)
+	require.NoError(t, err)
+	oldest := revisions[len(revisions)-1]
+	require.False(t, oldest.Deleted)
+	_, err = admin.RestoreDanceRevision(ctx, 1, oldest.Rev)
+	require.NoError(t, err)
+
+	// В источнике песня и видео перешли к другому танцу, а к восстановленному добавилась новая песня
+	require.NoError(t, service.UpsertSongs(ctx, []domain.SongShort{
+		{Id: 10, NameKey: "Shirak", DanceIds: []int64{2}},
+		{Id: 11, NameKey: "Berd", DanceIds: []int64{1, 2}},
+	}))
+	require.NoError(t, service.UpsertVideos(ctx, []domain.VideoShort{
+		{NameKey: "video", Link: "https://youtu.be/Wk61XUxP2Mg", Type: domain.Video, DanceIds: []int64{2}},
+	}))
+
+	danceSongs, err := querier.GetDanceSongs(ctx)
+	require.NoError(t, err)
+	songsByDance := make(map[int64][]int64)
+	for _, l := range danceSongs {
+		songsByDance[l.DanceID] = append(songsByDance[l.DanceID], l.SongID)
+	}
+	assert.Equal(t, []int64{10}, songsByDance[1])
+	assert.ElementsMatch(t, []int64{10, 11}, songsByDance[2])
+
+	danceVideos, err := querier.GetDanceVideos(ctx)
+	require.NoError(t, err)
+	videosByDance := make(map[int64]int)
+	for _, l := range danceVideos {
+		videosByDance[l.DanceID]++
+	}
+	assert.Equal(t, map[int64]int{1: 1, 2: 1}, videosByDance)
+}
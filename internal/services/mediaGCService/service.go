@@ -0,0 +1,128 @@
+package mediaGCService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
+)
+
+// FileKeysQuerier — часть db.Querier, нужная сборщику
+type FileKeysQuerier interface {
+	GetReferencedFileKeys(ctx context.Context) ([]string, error)
+}
+
+type Options struct {
+	// MinAge защищает свежие объекты: их мог только что загрузить импорт, который ещё не записал ключи в базу
+	MinAge time.Duration
+	DryRun bool
+}
+
+// Report — итог сборки: сколько объектов в бакете, какие из них ни на что не ссылаются и что удалено
+type Report struct {
+	Total      int
+	Referenced int
+	TooYoung   int
+	Orphans    []filestorage.FileInfo
+	Deleted    int
+	DryRun     bool
+}
+
+type MediaGCService interface {
+	Collect(ctx context.Context, opts Options) (*Report, error)
+}
+
+type mediaGCService struct {
+	querier FileKeysQuerier
+	storage filestorage.FileStorage
+	now     func() time.Time
+}
+
+func NewMediaGCService(querier FileKeysQuerier, storage filestorage.FileStorage) MediaGCService {
+	return &mediaGCService{
+		querier: querier,
+		storage: storage,
+		now:     time.Now,
+	}
+}
+
+// Collect находит объекты бакета, на которые не ссылается ни один танец или песня, и удаляет их,
+// если это не dry-run. Ошибка удаления одного объекта не останавливает остальные
+func (s *mediaGCService) Collect(ctx context.Context, opts Options) (*Report, error) {
+	// Сначала листинг, потом ключи из базы: объект, загруженный между запросами, окажется моложе MinAge
+	files, err := s.storage.ListFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list files: %w", err)
+	}
+	keys, err := s.querier.GetReferencedFileKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get referenced file keys: %w", err)
+	}
+	referenced := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		referenced[key] = true
+	}
+
+	report := &Report{Total: len(files), DryRun: opts.DryRun}
+	threshold := s.now().Add(-opts.MinAge)
+	for _, file := range files {
+		switch {
+		case referenced[file.Key]:
+			report.Referenced++
+		case file.LastModified.After(threshold):
+			report.TooYoung++
+		default:
+			report.Orphans = append(report.Orphans, file)
+		}
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	var errs []error
+	for _, file := range report.Orphans {
+		// Загрузка того же содержимого после листинга обновляет время объекта: на него вот-вот сошлются
+		object, err := s.storage.OpenFile(ctx, file.Key)
+		if errors.Is(err, filestorage.ErrFileNotFound) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("stat %s: %w", file.Key, err))
+			continue
+		}
+		_ = object.Close()
+		if object.LastModified.After(threshold) {
+			report.TooYoung++
+			continue
+		}
+
+		if err := s.storage.DeleteFile(ctx, file.Key); err != nil {
+			errs = append(errs, fmt.Errorf("delete %s: %w", file.Key, err))
+			continue
+		}
+		report.Deleted++
+	}
+
+	return report, errors.Join(errs...)
+}
+
+func (r *Report) WriteText(w io.Writer) error {
+	for _, file := range r.Orphans {
+		if _, err := fmt.Fprintf(w, "orphan %s (%d bytes, modified %s)\n", file.Key, file.Size, file.LastModified.Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "objects: %d, referenced: %d, orphans: %d, too young: %d, deleted: %d\n",
+		r.Total, r.Referenced, len(r.Orphans), r.TooYoung, r.Deleted); err != nil {
+		return err
+	}
+	if r.DryRun && len(r.Orphans) > 0 {
+		_, err := fmt.Fprintln(w, "dry run: nothing was deleted")
+		return err
+	}
+	return nil
+}
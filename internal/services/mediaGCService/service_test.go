@@ -0,0 +1,125 @@
+package mediaGCService
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
+	"github.com/Ari-Pari/backend/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fakeQuerier []string
+
+func (f fakeQuerier) GetReferencedFileKeys(context.Context) ([]string, error) {
+	return f, nil
+}
+
+var now = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestService(storage filestorage.FileStorage, keys ...string) *mediaGCService {
+	return &mediaGCService{
+		querier: fakeQuerier(keys),
+		storage: storage,
+		now:     func() time.Time { return now },
+	}
+}
+
+func bucketFiles() []filestorage.FileInfo {
+	return []filestorage.FileInfo{
+		{Key: "used.mp3", Size: 10, LastModified: now.Add(-72 * time.Hour)},
+		{Key: "old-orphan.jpeg", Size: 20, LastModified: now.Add(-48 * time.Hour)},
+		{Key: "fresh-orphan.mp3", Size: 30, LastModified: now.Add(-time.Hour)},
+	}
+}
+
+type readSeekNopCloser struct {
+	*bytes.Reader
+}
+
+func (readSeekNopCloser) Close() error {
+	return nil
+}
+
+func storedObject(modified time.Time) *filestorage.Object {
+	return &filestorage.Object{ReadSeekCloser: readSeekNopCloser{bytes.NewReader(nil)}, LastModified: modified}
+}
+
+func TestCollect_DeletesOldOrphans(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockFileStorage(ctrl)
+	storage.EXPECT().ListFiles(gomock.Any()).Return(bucketFiles(), nil)
+	storage.EXPECT().OpenFile(gomock.Any(), "old-orphan.jpeg").Return(storedObject(now.Add(-48*time.Hour)), nil)
+	storage.EXPECT().DeleteFile(gomock.Any(), "old-orphan.jpeg").Return(nil).Times(1)
+
+	report, err := newTestService(storage, "used.mp3").Collect(context.Background(), Options{MinAge: 24 * time.Hour})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Total)
+	assert.Equal(t, 1, report.Referenced)
+	assert.Equal(t, 1, report.TooYoung)
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, "old-orphan.jpeg", report.Orphans[0].Key)
+	assert.Equal(t, 1, report.Deleted)
+}
+
+func TestCollect_DryRunDeletesNothing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// DeleteFile не ожидается: gomock упадёт при любом вызове
+	storage := mocks.NewMockFileStorage(ctrl)
+	storage.EXPECT().ListFiles(gomock.Any()).Return(bucketFiles(), nil)
+
+	report, err := newTestService(storage, "used.mp3").Collect(context.Background(), Options{DryRun: true})
+
+	require.NoError(t, err)
+	require.Len(t, report.Orphans, 2)
+	assert.Equal(t, 0, report.Deleted)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteText(&buf))
+	assert.Contains(t, buf.String(), "orphan old-orphan.jpeg (20 bytes")
+	assert.Contains(t, buf.String(), "objects: 3, referenced: 1, orphans: 2, too young: 0, deleted: 0")
+	assert.Contains(t, buf.String(), "dry run: nothing was deleted")
+}
+
+func TestCollect_ContinuesAfterDeleteError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockFileStorage(ctrl)
+	storage.EXPECT().ListFiles(gomock.Any()).Return(bucketFiles(), nil)
+	storage.EXPECT().OpenFile(gomock.Any(), "old-orphan.jpeg").Return(storedObject(now.Add(-48*time.Hour)), nil)
+	storage.EXPECT().OpenFile(gomock.Any(), "fresh-orphan.mp3").Return(storedObject(now.Add(-time.Hour)), nil)
+	storage.EXPECT().DeleteFile(gomock.Any(), "old-orphan.jpeg").Return(assert.AnError)
+	storage.EXPECT().DeleteFile(gomock.Any(), "fresh-orphan.mp3").Return(nil)
+
+	report, err := newTestService(storage, "used.mp3").Collect(context.Background(), Options{MinAge: 0})
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "delete old-orphan.jpeg")
+	assert.Equal(t, 1, report.Deleted)
+}
+
+func TestCollect_SkipsReuploadedOrphans(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Тот же файл загрузили снова уже после листинга: время объекта обновилось, удалять его нельзя
+	storage := mocks.NewMockFileStorage(ctrl)
+	storage.EXPECT().ListFiles(gomock.Any()).Return(bucketFiles(), nil)
+	storage.EXPECT().OpenFile(gomock.Any(), "old-orphan.jpeg").Return(storedObject(now.Add(-time.Minute)), nil)
+
+	report, err := newTestService(storage, "used.mp3").Collect(context.Background(), Options{MinAge: 24 * time.Hour})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.TooYoung)
+	assert.Equal(t, 0, report.Deleted)
+}
@@ -0,0 +1,62 @@
+package photoVariantService
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+)
+
+// cwebpEncoder вызывает cwebp из libwebp: кодировщика WebP с потерями на чистом Go нет
+type cwebpEncoder struct {
+	path    string
+	quality int
+}
+
+// NewCWebPEncoder ищет cwebp по path (имя в PATH или путь к файлу). Если его нет, возвращает ошибку,
+// и вызывающий решает, обходиться ли без WebP
+func NewCWebPEncoder(path string, quality int) (WebPEncoder, error) {
+	resolved, err := exec.LookPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("cwebp not found: %w", err)
+	}
+	return &cwebpEncoder{path: resolved, quality: quality}, nil
+}
+
+// EncodeWebP передаёт картинку в cwebp через PNG без потерь во временной папке
+func (e *cwebpEncoder) EncodeWebP(ctx context.Context, img image.Image) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "cwebp-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "in.png")
+	output := filepath.Join(dir, "out.webp")
+
+	file, err := os.Create(input)
+	if err != nil {
+		return nil, err
+	}
+	if err = png.Encode(file, img); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if err = file.Close(); err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.path, "-quiet", "-q", strconv.Itoa(e.quality), input, "-o", output)
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("cwebp: %w: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	return os.ReadFile(output)
+}
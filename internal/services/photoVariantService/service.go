@@ -0,0 +1,135 @@
+package photoVariantService
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/Ari-Pari/backend/internal/clients/filestorage"
+	"github.com/Ari-Pari/backend/internal/domain"
+	"golang.org/x/image/draw"
+)
+
+// ErrUndecodable — файл не удалось разобрать как JPEG или PNG
+var ErrUndecodable = errors.New("image is not decodable")
+
+// Size — вариант фото, ширина которого не больше Width
+type Size struct {
+	Name  string
+	Width int
+}
+
+// Sizes — варианты для списка танцев, карточки и полноэкранного просмотра
+var Sizes = []Size{
+	{Name: "thumb", Width: 320},
+	{Name: "card", Width: 800},
+	{Name: "full", Width: 1600},
+}
+
+const (
+	FormatJPEG = "jpeg"
+	FormatWebP = "webp"
+
+	jpegQuality = 85
+)
+
+// WebPEncoder кодирует картинку в WebP
+type WebPEncoder interface {
+	EncodeWebP(ctx context.Context, img image.Image) ([]byte, error)
+}
+
+type PhotoVariantService interface {
+	UploadPhoto(ctx context.Context, originalName string, reader io.Reader) (string, []domain.PhotoVariant, error)
+}
+
+type photoVariantService struct {
+	storage filestorage.FileStorage
+	webp    WebPEncoder
+}
+
+// NewPhotoVariantService загружает фото вместе с вариантами. Без webp варианты делаются только в JPEG
+func NewPhotoVariantService(storage filestorage.FileStorage, webp WebPEncoder) PhotoVariantService {
+	return &photoVariantService{storage: storage, webp: webp}
+}
+
+// UploadPhoto загружает исходное фото и его уменьшенные копии из Sizes в JPEG и WebP.
+// Фото меньше варианта не растягивается: такой вариант совпадает по размеру с исходным
+func (s *photoVariantService) UploadPhoto(ctx context.Context, originalName string, reader io.Reader) (string, []domain.PhotoVariant, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", nil, err
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %s: %v", ErrUndecodable, originalName, err)
+	}
+
+	key, err := s.storage.UploadFile(ctx, originalName, bytes.NewReader(data), int64(len(data)), "image/"+format)
+	if err != nil {
+		return "", nil, err
+	}
+
+	base := strings.TrimSuffix(path.Base(originalName), path.Ext(originalName))
+	var variants []domain.PhotoVariant
+	for _, size := range Sizes {
+		resized := resize(img, size.Width)
+		bounds := resized.Bounds()
+
+		var jpegData bytes.Buffer
+		if err = jpeg.Encode(&jpegData, resized, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return "", nil, fmt.Errorf("encode %s %s: %w", size.Name, FormatJPEG, err)
+		}
+		encoded := map[string][]byte{FormatJPEG: jpegData.Bytes()}
+		formats := []string{FormatJPEG}
+
+		if s.webp != nil {
+			webpData, err := s.webp.EncodeWebP(ctx, resized)
+			if err != nil {
+				return "", nil, fmt.Errorf("encode %s %s: %w", size.Name, FormatWebP, err)
+			}
+			encoded[FormatWebP] = webpData
+			formats = append(formats, FormatWebP)
+		}
+
+		for _, format := range formats {
+			name := fmt.Sprintf("%s-%s.%s", base, size.Name, format)
+			variantKey, err := s.storage.UploadFile(ctx, name, bytes.NewReader(encoded[format]), int64(len(encoded[format])), "image/"+format)
+			if err != nil {
+				return "", nil, err
+			}
+			variants = append(variants, domain.PhotoVariant{
+				Size:   size.Name,
+				Format: format,
+				Width:  bounds.Dx(),
+				Height: bounds.Dy(),
+				Key:    variantKey,
+			})
+		}
+	}
+
+	return key, variants, nil
+}
+
+// resize уменьшает картинку до ширины width с сохранением пропорций
+func resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if bounds.Dx() <= width {
+		width = bounds.Dx()
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
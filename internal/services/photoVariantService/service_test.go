@@ -0,0 +1,126 @@
+package photoVariantService
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/Ari-Pari/backend/internal/domain"
+	"github.com/Ari-Pari/backend/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// testPNG — картинка 1000x500: больше thumb и card, но меньше full
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 1000, 500))
+	for x := 0; x < 1000; x++ {
+		img.Set(x, x/2, color.RGBA{R: 200, A: 255})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// recordUploads отвечает ключом из имени файла и запоминает тип содержимого
+func recordUploads(storage *mocks.MockFileStorage, uploaded map[string]string) {
+	storage.EXPECT().
+		UploadFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, name string, reader io.Reader, size int64, contentType string) (string, error) {
+			data, err := io.ReadAll(reader)
+			if err != nil || int64(len(data)) != size {
+				return "", io.ErrUnexpectedEOF
+			}
+			uploaded[name] = contentType
+			return "key/" + filepath.Base(name), nil
+		}).
+		AnyTimes()
+}
+
+func TestUploadPhoto_JPEGOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storage := mocks.NewMockFileStorage(ctrl)
+	uploaded := map[string]string{}
+	recordUploads(storage, uploaded)
+
+	service := NewPhotoVariantService(storage, nil)
+	key, variants, err := service.UploadPhoto(context.Background(), "photos/12.png", bytes.NewReader(testPNG(t)))
+
+	require.NoError(t, err)
+	assert.Equal(t, "key/12.png", key)
+	assert.Equal(t, map[string]string{
+		"photos/12.png": "image/png",
+		"12-thumb.jpeg": "image/jpeg",
+		"12-card.jpeg":  "image/jpeg",
+		"12-full.jpeg":  "image/jpeg",
+	}, uploaded)
+	assert.Equal(t, []domain.PhotoVariant{
+		{Size: "thumb", Format: FormatJPEG, Width: 320, Height: 160, Key: "key/12-thumb.jpeg"},
+		{Size: "card", Format: FormatJPEG, Width: 800, Height: 400, Key: "key/12-card.jpeg"},
+		{Size: "full", Format: FormatJPEG, Width: 1000, Height: 500, Key: "key/12-full.jpeg"},
+	}, variants)
+}
+
+func TestUploadPhoto_WithWebP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	storage := mocks.NewMockFileStorage(ctrl)
+	uploaded := map[string]string{}
+	recordUploads(storage, uploaded)
+
+	webp := mocks.NewMockWebPEncoder(ctrl)
+	webp.EXPECT().
+		EncodeWebP(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, img image.Image) ([]byte, error) {
+			return []byte("RIFF" + strings.Repeat("w", img.Bounds().Dx()/100)), nil
+		}).
+		Times(len(Sizes))
+
+	service := NewPhotoVariantService(storage, webp)
+	_, variants, err := service.UploadPhoto(context.Background(), "12.png", bytes.NewReader(testPNG(t)))
+
+	require.NoError(t, err)
+	require.Len(t, variants, 2*len(Sizes))
+	assert.Equal(t, domain.PhotoVariant{Size: "thumb", Format: FormatWebP, Width: 320, Height: 160, Key: "key/12-thumb.webp"}, variants[1])
+	assert.Equal(t, "image/webp", uploaded["12-card.webp"])
+}
+
+func TestUploadPhoto_Undecodable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// Нечитаемое фото не должно попасть в хранилище
+	storage := mocks.NewMockFileStorage(ctrl)
+
+	service := NewPhotoVariantService(storage, nil)
+	_, _, err := service.UploadPhoto(context.Background(), "12.jpeg", strings.NewReader("not an image"))
+
+	require.ErrorIs(t, err, ErrUndecodable)
+	assert.Contains(t, err.Error(), "12.jpeg")
+}
+
+func TestCWebPEncoder(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("stub encoder is a shell script")
+	}
+	// Заглушка с теми же аргументами, что у cwebp: -quiet -q N <in> -o <out>
+	stub := filepath.Join(t.TempDir(), "cwebp")
+	require.NoError(t, os.WriteFile(stub, []byte("#!/bin/sh\nprintf 'RIFF-%s' \"$3\" > \"$6\"\n"), 0o755))
+
+	encoder, err := NewCWebPEncoder(stub, 80)
+	require.NoError(t, err)
+
+	data, err := encoder.EncodeWebP(context.Background(), image.NewRGBA(image.Rect(0, 0, 2, 2)))
+	require.NoError(t, err)
+	assert.Equal(t, "RIFF-80", string(data))
+
+	_, err = NewCWebPEncoder(filepath.Join(t.TempDir(), "missing"), 80)
+	assert.Error(t, err)
+}
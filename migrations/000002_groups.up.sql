@@ -0,0 +1,15 @@
+CREATE TABLE groups (
+    id BIGSERIAL PRIMARY KEY,
+    translation_id BIGINT,
+    name VARCHAR NOT NULL,
+    flag VARCHAR NOT NULL DEFAULT '',
+    country_code VARCHAR(2) NOT NULL DEFAULT '',
+    link VARCHAR NOT NULL DEFAULT '',
+    type VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_groups_type ON groups (type);
+CREATE INDEX idx_groups_country_code ON groups (country_code);
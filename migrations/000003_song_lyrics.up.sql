@@ -0,0 +1,3 @@
+ALTER TABLE songs
+    ADD COLUMN lyrics_html TEXT NOT NULL DEFAULT '',
+    ADD COLUMN lyrics_text TEXT NOT NULL DEFAULT '';
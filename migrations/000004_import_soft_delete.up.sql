@@ -0,0 +1,4 @@
+-- Импорт обновляет данные на месте: строки, пропавшие из исходных файлов, скрываются, а не удаляются
+ALTER TABLE regions ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE songs ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE videos ADD COLUMN deleted_at TIMESTAMPTZ;
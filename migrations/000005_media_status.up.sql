@@ -0,0 +1,11 @@
+-- Статус медиа после импорта: OK, MISSING (файл не найден) или UNREADABLE (файл пустой или не читается)
+ALTER TABLE dances
+    ADD COLUMN media_status VARCHAR NOT NULL DEFAULT 'OK'
+        CHECK (media_status IN ('OK', 'MISSING', 'UNREADABLE'));
+ALTER TABLE songs
+    ADD COLUMN media_status VARCHAR NOT NULL DEFAULT 'OK'
+        CHECK (media_status IN ('OK', 'MISSING', 'UNREADABLE'));
+
+-- Строки, загруженные до появления статуса, с пустым ключом файла считаются без медиа
+UPDATE dances SET media_status = 'MISSING' WHERE photo_key IS NULL OR photo_key = '';
+UPDATE songs SET media_status = 'MISSING' WHERE file_key = '';
@@ -0,0 +1,3 @@
+-- Сколько раз песню начинали слушать через /songs/{id}/audio
+ALTER TABLE songs
+    ADD COLUMN play_count INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+-- Уменьшенные копии фото танца: [{"size": "thumb", "format": "webp", "width": 320, "height": 240, "key": "..."}]
+ALTER TABLE dances
+    ADD COLUMN photo_variants JSONB NOT NULL DEFAULT '[]';
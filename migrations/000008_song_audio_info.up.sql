@@ -0,0 +1,13 @@
+-- Битый MP3 (нет ни одного корректного MPEG-кадра) получает статус CORRUPT, но файл всё равно загружается
+ALTER TABLE songs DROP CONSTRAINT songs_media_status_check;
+ALTER TABLE songs
+    ADD CONSTRAINT songs_media_status_check
+        CHECK (media_status IN ('OK', 'MISSING', 'UNREADABLE', 'CORRUPT'));
+
+-- Данные из заголовков MPEG-кадров и ID3-тегов, NULL если файл не разобран
+ALTER TABLE songs
+    ADD COLUMN duration_ms    INTEGER,
+    ADD COLUMN bitrate_kbps   INTEGER,
+    ADD COLUMN sample_rate_hz INTEGER,
+    ADD COLUMN tag_title      VARCHAR NOT NULL DEFAULT '',
+    ADD COLUMN tag_artist     VARCHAR NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- id танцев, созданных через админку. Импорт берёт id из dances.json, поэтому последовательность начинается с запасом
+CREATE SEQUENCE dances_admin_id_seq START WITH 1000000 OWNED BY dances.id;
+
+-- Танцы из админки не описаны в dances.json, и импорт не должен помечать их удалёнными
+ALTER TABLE dances
+    ADD COLUMN created_in_admin BOOLEAN NOT NULL DEFAULT FALSE;
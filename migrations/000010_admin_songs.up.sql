@@ -0,0 +1,6 @@
+-- id песен, созданных через админку. Импорт берёт id из musics.json, поэтому последовательность начинается с запасом
+CREATE SEQUENCE songs_admin_id_seq START WITH 1000000 OWNED BY songs.id;
+
+-- Песни из админки не описаны в musics.json, и импорт не должен помечать их удалёнными
+ALTER TABLE songs
+    ADD COLUMN created_in_admin BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+-- Видео из админки не описаны в videos.json, и импорт не должен помечать их удалёнными
+ALTER TABLE videos
+    ADD COLUMN created_in_admin BOOLEAN NOT NULL DEFAULT FALSE;
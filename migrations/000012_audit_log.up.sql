@@ -0,0 +1,16 @@
+-- Журнал изменений каталога. diff хранит изменившиеся поля в виде {"поле": {"old": ..., "new": ...}}
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor VARCHAR NOT NULL,
+    entity_type VARCHAR NOT NULL,
+    entity_id BIGINT NOT NULL,
+    action VARCHAR NOT NULL,
+    diff JSONB NOT NULL DEFAULT '{}',
+    -- общий для всех записей одного запуска автозагрузки, у правок из админки пустой
+    import_run_id UUID
+);
+
+CREATE INDEX audit_log_entity_idx ON audit_log (entity_type, entity_id);
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
+CREATE INDEX audit_log_import_run_id_idx ON audit_log (import_run_id) WHERE import_run_id IS NOT NULL;
@@ -0,0 +1,25 @@
+-- Версии танца: снимок полей, перевода и связей после каждого изменения.
+-- Внешних ключей нет, как и у связей: история переживает полную перезаливку импортом
+CREATE TABLE dance_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    dance_id BIGINT NOT NULL,
+    rev INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- пустой у снимка состояния, которое было до начала истории или менялось в обход неё
+    actor VARCHAR,
+    import_run_id UUID,
+    name VARCHAR NOT NULL,
+    eng_name VARCHAR,
+    ru_name VARCHAR,
+    arm_name VARCHAR,
+    complexity INTEGER,
+    gender VARCHAR NOT NULL,
+    paces INTEGER[] NOT NULL,
+    genres TEXT[] NOT NULL,
+    handshakes TEXT[] NOT NULL,
+    deleted BOOLEAN NOT NULL,
+    region_ids BIGINT[] NOT NULL,
+    song_ids BIGINT[] NOT NULL,
+    video_ids BIGINT[] NOT NULL,
+    CONSTRAINT unique_dance_revision UNIQUE (dance_id, rev)
+);
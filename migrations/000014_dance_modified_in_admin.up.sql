@@ -0,0 +1,4 @@
+-- Танцы из dances.json, которые правили или удаляли в админке. Импорт больше не трогает их поля,
+-- переводы, пометку об удалении и регионы: правка редактора важнее источника
+ALTER TABLE dances
+    ADD COLUMN modified_in_admin BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,4 @@
+-- Песни из musics.json, которые правили или удаляли в админке. Импорт больше не трогает их поля,
+-- переводы, файл, пометку об удалении и связи с танцами и ансамблями, как и у танцев с modified_in_admin
+ALTER TABLE songs
+    ADD COLUMN modified_in_admin BOOLEAN NOT NULL DEFAULT FALSE;
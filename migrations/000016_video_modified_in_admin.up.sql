@@ -0,0 +1,4 @@
+-- Видео, которые правили или удаляли в админке. Импорт сопоставляет их по ссылке, но больше не меняет
+-- их поля, переводы, пометку об удалении и связи с танцами
+ALTER TABLE videos
+    ADD COLUMN modified_in_admin BOOLEAN NOT NULL DEFAULT FALSE;